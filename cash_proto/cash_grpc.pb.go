@@ -25,13 +25,98 @@ const _ = grpc.SupportPackageIsVersion7
 type CacheServiceClient interface {
 	Set(ctx context.Context, in *String, opts ...grpc.CallOption) (*Response, error)
 	Get(ctx context.Context, in *Key, opts ...grpc.CallOption) (*String, error)
+	SetCond(ctx context.Context, in *SetItem, opts ...grpc.CallOption) (*SetResult, error)
+	GetSet(ctx context.Context, in *String, opts ...grpc.CallOption) (*String, error)
 	DeleteKey(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Response, error)
+	IncrBy(ctx context.Context, in *CounterRequest, opts ...grpc.CallOption) (*Count, error)
 	LPush(ctx context.Context, in *String, opts ...grpc.CallOption) (*Response, error)
 	RPush(ctx context.Context, in *String, opts ...grpc.CallOption) (*Response, error)
 	GetList(ctx context.Context, in *Key, opts ...grpc.CallOption) (*List, error)
 	HMSet(ctx context.Context, in *HashMapItem, opts ...grpc.CallOption) (*Response, error)
 	GetHashMap(ctx context.Context, in *Key, opts ...grpc.CallOption) (*List, error)
+	SAdd(ctx context.Context, in *SetMembersRequest, opts ...grpc.CallOption) (*Count, error)
+	SRem(ctx context.Context, in *SetMembersRequest, opts ...grpc.CallOption) (*Count, error)
+	SMembers(ctx context.Context, in *Key, opts ...grpc.CallOption) (*List, error)
 	DeleteAll(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Response, error)
+	AcquireLock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*Lock, error)
+	RenewLock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*Lock, error)
+	ReleaseLock(ctx context.Context, in *Lock, opts ...grpc.CallOption) (*Response, error)
+	Publish(ctx context.Context, in *Message, opts ...grpc.CallOption) (*PublishResult, error)
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (CacheService_SubscribeClient, error)
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (CacheService_WatchClient, error)
+	XAdd(ctx context.Context, in *StreamAddRequest, opts ...grpc.CallOption) (*StreamID, error)
+	XLen(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Count, error)
+	XRange(ctx context.Context, in *StreamRangeRequest, opts ...grpc.CallOption) (*StreamEntries, error)
+	XTrim(ctx context.Context, in *StreamTrimRequest, opts ...grpc.CallOption) (*Count, error)
+	XRead(ctx context.Context, in *StreamReadRequest, opts ...grpc.CallOption) (*StreamEntries, error)
+	XGroupCreate(ctx context.Context, in *StreamGroupRequest, opts ...grpc.CallOption) (*Response, error)
+	XReadGroup(ctx context.Context, in *StreamReadGroupRequest, opts ...grpc.CallOption) (*StreamEntries, error)
+	XAck(ctx context.Context, in *StreamAckRequest, opts ...grpc.CallOption) (*Count, error)
+	XPending(ctx context.Context, in *StreamPendingRequest, opts ...grpc.CallOption) (*StreamPendingList, error)
+	XClaim(ctx context.Context, in *StreamClaimRequest, opts ...grpc.CallOption) (*StreamEntries, error)
+	PFAdd(ctx context.Context, in *HLLAddRequest, opts ...grpc.CallOption) (*Response, error)
+	PFCount(ctx context.Context, in *Keys, opts ...grpc.CallOption) (*Count, error)
+	PFMerge(ctx context.Context, in *HLLMergeRequest, opts ...grpc.CallOption) (*Response, error)
+	BFReserve(ctx context.Context, in *BloomReserveRequest, opts ...grpc.CallOption) (*Response, error)
+	BFAdd(ctx context.Context, in *FilterItems, opts ...grpc.CallOption) (*Results, error)
+	BFExists(ctx context.Context, in *FilterItems, opts ...grpc.CallOption) (*Results, error)
+	BFInfo(ctx context.Context, in *Key, opts ...grpc.CallOption) (*FilterInfo, error)
+	CFReserve(ctx context.Context, in *CuckooReserveRequest, opts ...grpc.CallOption) (*Response, error)
+	CFAdd(ctx context.Context, in *FilterItems, opts ...grpc.CallOption) (*Results, error)
+	CFExists(ctx context.Context, in *FilterItems, opts ...grpc.CallOption) (*Results, error)
+	CFDel(ctx context.Context, in *FilterItems, opts ...grpc.CallOption) (*Results, error)
+	CFInfo(ctx context.Context, in *Key, opts ...grpc.CallOption) (*FilterInfo, error)
+	CMSInit(ctx context.Context, in *CMSInitRequest, opts ...grpc.CallOption) (*Response, error)
+	CMSIncrBy(ctx context.Context, in *IncrRequest, opts ...grpc.CallOption) (*Counts, error)
+	CMSQuery(ctx context.Context, in *FilterItems, opts ...grpc.CallOption) (*Counts, error)
+	CMSMerge(ctx context.Context, in *CMSMergeRequest, opts ...grpc.CallOption) (*Response, error)
+	CMSInfo(ctx context.Context, in *Key, opts ...grpc.CallOption) (*SketchInfo, error)
+	TopKReserve(ctx context.Context, in *TopKReserveRequest, opts ...grpc.CallOption) (*Response, error)
+	TopKIncrBy(ctx context.Context, in *IncrRequest, opts ...grpc.CallOption) (*ItemList, error)
+	TopKQuery(ctx context.Context, in *FilterItems, opts ...grpc.CallOption) (*Results, error)
+	TopKList(ctx context.Context, in *Key, opts ...grpc.CallOption) (*TopKItems, error)
+	SetBit(ctx context.Context, in *BitRequest, opts ...grpc.CallOption) (*Count, error)
+	GetBit(ctx context.Context, in *BitRequest, opts ...grpc.CallOption) (*Count, error)
+	BitCount(ctx context.Context, in *BitCountRequest, opts ...grpc.CallOption) (*Count, error)
+	BitPos(ctx context.Context, in *BitPosRequest, opts ...grpc.CallOption) (*Count, error)
+	BitOp(ctx context.Context, in *BitOpRequest, opts ...grpc.CallOption) (*Count, error)
+	BitField(ctx context.Context, in *BitFieldRequest, opts ...grpc.CallOption) (*BitFieldResult, error)
+	GeoAdd(ctx context.Context, in *GeoAddRequest, opts ...grpc.CallOption) (*Count, error)
+	GeoRem(ctx context.Context, in *GeoMembersRequest, opts ...grpc.CallOption) (*Count, error)
+	GeoPos(ctx context.Context, in *GeoMembersRequest, opts ...grpc.CallOption) (*GeoPositions, error)
+	GeoDist(ctx context.Context, in *GeoDistRequest, opts ...grpc.CallOption) (*GeoDistance, error)
+	GeoSearch(ctx context.Context, in *GeoSearchRequest, opts ...grpc.CallOption) (*GeoResults, error)
+	JSONSet(ctx context.Context, in *JSONSetRequest, opts ...grpc.CallOption) (*Response, error)
+	JSONGet(ctx context.Context, in *JSONPathRequest, opts ...grpc.CallOption) (*JSONValue, error)
+	JSONDel(ctx context.Context, in *JSONPathRequest, opts ...grpc.CallOption) (*Count, error)
+	JSONNumIncrBy(ctx context.Context, in *JSONNumRequest, opts ...grpc.CallOption) (*JSONValue, error)
+	JSONArrAppend(ctx context.Context, in *JSONArrRequest, opts ...grpc.CallOption) (*Count, error)
+	JSONArrInsert(ctx context.Context, in *JSONArrRequest, opts ...grpc.CallOption) (*Count, error)
+	JSONArrPop(ctx context.Context, in *JSONArrRequest, opts ...grpc.CallOption) (*JSONValue, error)
+	JSONType(ctx context.Context, in *JSONPathRequest, opts ...grpc.CallOption) (*JSONValue, error)
+	JSONLen(ctx context.Context, in *JSONPathRequest, opts ...grpc.CallOption) (*Count, error)
+	TSCreate(ctx context.Context, in *TSCreateRequest, opts ...grpc.CallOption) (*Response, error)
+	TSAdd(ctx context.Context, in *TSAddRequest, opts ...grpc.CallOption) (*Sample, error)
+	TSGet(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Sample, error)
+	TSRange(ctx context.Context, in *TSRangeRequest, opts ...grpc.CallOption) (*Samples, error)
+	TSCreateRule(ctx context.Context, in *TSRuleRequest, opts ...grpc.CallOption) (*Response, error)
+	TSDeleteRule(ctx context.Context, in *TSRuleRequest, opts ...grpc.CallOption) (*Response, error)
+	TSInfo(ctx context.Context, in *Key, opts ...grpc.CallOption) (*TSInfoResult, error)
+	TSQueryIndex(ctx context.Context, in *TSLabelFilter, opts ...grpc.CallOption) (*Keys, error)
+	VCreate(ctx context.Context, in *VectorCreateRequest, opts ...grpc.CallOption) (*Response, error)
+	VAdd(ctx context.Context, in *VectorAddRequest, opts ...grpc.CallOption) (*Count, error)
+	VDel(ctx context.Context, in *VectorIDs, opts ...grpc.CallOption) (*Count, error)
+	VGet(ctx context.Context, in *VectorIDs, opts ...grpc.CallOption) (*Vectors, error)
+	VSearch(ctx context.Context, in *VectorSearchRequest, opts ...grpc.CallOption) (*VectorResults, error)
+	VInfo(ctx context.Context, in *Key, opts ...grpc.CallOption) (*VectorInfo, error)
+	CreateIndex(ctx context.Context, in *IndexDefinition, opts ...grpc.CallOption) (*Response, error)
+	DropIndex(ctx context.Context, in *IndexName, opts ...grpc.CallOption) (*Response, error)
+	IndexInfo(ctx context.Context, in *IndexName, opts ...grpc.CallOption) (*IndexInfoResult, error)
+	QueryIndex(ctx context.Context, in *IndexQuery, opts ...grpc.CallOption) (*IndexQueryResult, error)
+	FTCreate(ctx context.Context, in *SearchIndexDefinition, opts ...grpc.CallOption) (*Response, error)
+	FTDrop(ctx context.Context, in *IndexName, opts ...grpc.CallOption) (*Response, error)
+	FTInfo(ctx context.Context, in *IndexName, opts ...grpc.CallOption) (*SearchIndexInfo, error)
+	FTSearch(ctx context.Context, in *SearchQuery, opts ...grpc.CallOption) (*SearchResults, error)
 }
 
 type cacheServiceClient struct {
@@ -60,333 +145,4785 @@ func (c *cacheServiceClient) Get(ctx context.Context, in *Key, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *cacheServiceClient) SetCond(ctx context.Context, in *SetItem, opts ...grpc.CallOption) (*SetResult, error) {
+	out := new(SetResult)
+	err := c.cc.Invoke(ctx, "/CacheService/SetCond", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetSet(ctx context.Context, in *String, opts ...grpc.CallOption) (*String, error) {
+	out := new(String)
+	err := c.cc.Invoke(ctx, "/CacheService/GetSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cacheServiceClient) DeleteKey(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Response, error) {
 	out := new(Response)
 	err := c.cc.Invoke(ctx, "/CacheService/DeleteKey", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *cacheServiceClient) IncrBy(ctx context.Context, in *CounterRequest, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/CacheService/IncrBy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) LPush(ctx context.Context, in *String, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/LPush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) RPush(ctx context.Context, in *String, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/RPush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetList(ctx context.Context, in *Key, opts ...grpc.CallOption) (*List, error) {
+	out := new(List)
+	err := c.cc.Invoke(ctx, "/CacheService/GetList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) HMSet(ctx context.Context, in *HashMapItem, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/HMSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetHashMap(ctx context.Context, in *Key, opts ...grpc.CallOption) (*List, error) {
+	out := new(List)
+	err := c.cc.Invoke(ctx, "/CacheService/GetHashMap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) SAdd(ctx context.Context, in *SetMembersRequest, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/CacheService/SAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) SRem(ctx context.Context, in *SetMembersRequest, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/CacheService/SRem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) SMembers(ctx context.Context, in *Key, opts ...grpc.CallOption) (*List, error) {
+	out := new(List)
+	err := c.cc.Invoke(ctx, "/CacheService/SMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DeleteAll(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/DeleteAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) AcquireLock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*Lock, error) {
+	out := new(Lock)
+	err := c.cc.Invoke(ctx, "/CacheService/AcquireLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) RenewLock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*Lock, error) {
+	out := new(Lock)
+	err := c.cc.Invoke(ctx, "/CacheService/RenewLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) ReleaseLock(ctx context.Context, in *Lock, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/ReleaseLock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Publish(ctx context.Context, in *Message, opts ...grpc.CallOption) (*PublishResult, error) {
+	out := new(PublishResult)
+	err := c.cc.Invoke(ctx, "/CacheService/Publish", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (CacheService_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CacheService_ServiceDesc.Streams[0], "/CacheService/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cacheServiceSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CacheService_SubscribeClient interface {
+	Recv() (*Message, error)
+	grpc.ClientStream
+}
+
+type cacheServiceSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *cacheServiceSubscribeClient) Recv() (*Message, error) {
+	m := new(Message)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cacheServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (CacheService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CacheService_ServiceDesc.Streams[1], "/CacheService/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cacheServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CacheService_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type cacheServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *cacheServiceWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *cacheServiceClient) XAdd(ctx context.Context, in *StreamAddRequest, opts ...grpc.CallOption) (*StreamID, error) {
+	out := new(StreamID)
+	err := c.cc.Invoke(ctx, "/CacheService/XAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) XLen(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/CacheService/XLen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) XRange(ctx context.Context, in *StreamRangeRequest, opts ...grpc.CallOption) (*StreamEntries, error) {
+	out := new(StreamEntries)
+	err := c.cc.Invoke(ctx, "/CacheService/XRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) XTrim(ctx context.Context, in *StreamTrimRequest, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/CacheService/XTrim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) XRead(ctx context.Context, in *StreamReadRequest, opts ...grpc.CallOption) (*StreamEntries, error) {
+	out := new(StreamEntries)
+	err := c.cc.Invoke(ctx, "/CacheService/XRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) XGroupCreate(ctx context.Context, in *StreamGroupRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/XGroupCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) XReadGroup(ctx context.Context, in *StreamReadGroupRequest, opts ...grpc.CallOption) (*StreamEntries, error) {
+	out := new(StreamEntries)
+	err := c.cc.Invoke(ctx, "/CacheService/XReadGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) XAck(ctx context.Context, in *StreamAckRequest, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/CacheService/XAck", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) XPending(ctx context.Context, in *StreamPendingRequest, opts ...grpc.CallOption) (*StreamPendingList, error) {
+	out := new(StreamPendingList)
+	err := c.cc.Invoke(ctx, "/CacheService/XPending", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) XClaim(ctx context.Context, in *StreamClaimRequest, opts ...grpc.CallOption) (*StreamEntries, error) {
+	out := new(StreamEntries)
+	err := c.cc.Invoke(ctx, "/CacheService/XClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PFAdd(ctx context.Context, in *HLLAddRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/PFAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PFCount(ctx context.Context, in *Keys, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/CacheService/PFCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) PFMerge(ctx context.Context, in *HLLMergeRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/PFMerge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) BFReserve(ctx context.Context, in *BloomReserveRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/BFReserve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) BFAdd(ctx context.Context, in *FilterItems, opts ...grpc.CallOption) (*Results, error) {
+	out := new(Results)
+	err := c.cc.Invoke(ctx, "/CacheService/BFAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) BFExists(ctx context.Context, in *FilterItems, opts ...grpc.CallOption) (*Results, error) {
+	out := new(Results)
+	err := c.cc.Invoke(ctx, "/CacheService/BFExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) BFInfo(ctx context.Context, in *Key, opts ...grpc.CallOption) (*FilterInfo, error) {
+	out := new(FilterInfo)
+	err := c.cc.Invoke(ctx, "/CacheService/BFInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) CFReserve(ctx context.Context, in *CuckooReserveRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/CFReserve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) CFAdd(ctx context.Context, in *FilterItems, opts ...grpc.CallOption) (*Results, error) {
+	out := new(Results)
+	err := c.cc.Invoke(ctx, "/CacheService/CFAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) CFExists(ctx context.Context, in *FilterItems, opts ...grpc.CallOption) (*Results, error) {
+	out := new(Results)
+	err := c.cc.Invoke(ctx, "/CacheService/CFExists", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) CFDel(ctx context.Context, in *FilterItems, opts ...grpc.CallOption) (*Results, error) {
+	out := new(Results)
+	err := c.cc.Invoke(ctx, "/CacheService/CFDel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) CFInfo(ctx context.Context, in *Key, opts ...grpc.CallOption) (*FilterInfo, error) {
+	out := new(FilterInfo)
+	err := c.cc.Invoke(ctx, "/CacheService/CFInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) CMSInit(ctx context.Context, in *CMSInitRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/CMSInit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) CMSIncrBy(ctx context.Context, in *IncrRequest, opts ...grpc.CallOption) (*Counts, error) {
+	out := new(Counts)
+	err := c.cc.Invoke(ctx, "/CacheService/CMSIncrBy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) CMSQuery(ctx context.Context, in *FilterItems, opts ...grpc.CallOption) (*Counts, error) {
+	out := new(Counts)
+	err := c.cc.Invoke(ctx, "/CacheService/CMSQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) CMSMerge(ctx context.Context, in *CMSMergeRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/CMSMerge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) CMSInfo(ctx context.Context, in *Key, opts ...grpc.CallOption) (*SketchInfo, error) {
+	out := new(SketchInfo)
+	err := c.cc.Invoke(ctx, "/CacheService/CMSInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) TopKReserve(ctx context.Context, in *TopKReserveRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/TopKReserve", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) TopKIncrBy(ctx context.Context, in *IncrRequest, opts ...grpc.CallOption) (*ItemList, error) {
+	out := new(ItemList)
+	err := c.cc.Invoke(ctx, "/CacheService/TopKIncrBy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) TopKQuery(ctx context.Context, in *FilterItems, opts ...grpc.CallOption) (*Results, error) {
+	out := new(Results)
+	err := c.cc.Invoke(ctx, "/CacheService/TopKQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) TopKList(ctx context.Context, in *Key, opts ...grpc.CallOption) (*TopKItems, error) {
+	out := new(TopKItems)
+	err := c.cc.Invoke(ctx, "/CacheService/TopKList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) SetBit(ctx context.Context, in *BitRequest, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/CacheService/SetBit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GetBit(ctx context.Context, in *BitRequest, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/CacheService/GetBit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) BitCount(ctx context.Context, in *BitCountRequest, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/CacheService/BitCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) BitPos(ctx context.Context, in *BitPosRequest, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/CacheService/BitPos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) BitOp(ctx context.Context, in *BitOpRequest, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/CacheService/BitOp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) BitField(ctx context.Context, in *BitFieldRequest, opts ...grpc.CallOption) (*BitFieldResult, error) {
+	out := new(BitFieldResult)
+	err := c.cc.Invoke(ctx, "/CacheService/BitField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GeoAdd(ctx context.Context, in *GeoAddRequest, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/CacheService/GeoAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GeoRem(ctx context.Context, in *GeoMembersRequest, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/CacheService/GeoRem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GeoPos(ctx context.Context, in *GeoMembersRequest, opts ...grpc.CallOption) (*GeoPositions, error) {
+	out := new(GeoPositions)
+	err := c.cc.Invoke(ctx, "/CacheService/GeoPos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GeoDist(ctx context.Context, in *GeoDistRequest, opts ...grpc.CallOption) (*GeoDistance, error) {
+	out := new(GeoDistance)
+	err := c.cc.Invoke(ctx, "/CacheService/GeoDist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) GeoSearch(ctx context.Context, in *GeoSearchRequest, opts ...grpc.CallOption) (*GeoResults, error) {
+	out := new(GeoResults)
+	err := c.cc.Invoke(ctx, "/CacheService/GeoSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) JSONSet(ctx context.Context, in *JSONSetRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/JSONSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) JSONGet(ctx context.Context, in *JSONPathRequest, opts ...grpc.CallOption) (*JSONValue, error) {
+	out := new(JSONValue)
+	err := c.cc.Invoke(ctx, "/CacheService/JSONGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) JSONDel(ctx context.Context, in *JSONPathRequest, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/CacheService/JSONDel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) JSONNumIncrBy(ctx context.Context, in *JSONNumRequest, opts ...grpc.CallOption) (*JSONValue, error) {
+	out := new(JSONValue)
+	err := c.cc.Invoke(ctx, "/CacheService/JSONNumIncrBy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) JSONArrAppend(ctx context.Context, in *JSONArrRequest, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/CacheService/JSONArrAppend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) JSONArrInsert(ctx context.Context, in *JSONArrRequest, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/CacheService/JSONArrInsert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) JSONArrPop(ctx context.Context, in *JSONArrRequest, opts ...grpc.CallOption) (*JSONValue, error) {
+	out := new(JSONValue)
+	err := c.cc.Invoke(ctx, "/CacheService/JSONArrPop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) JSONType(ctx context.Context, in *JSONPathRequest, opts ...grpc.CallOption) (*JSONValue, error) {
+	out := new(JSONValue)
+	err := c.cc.Invoke(ctx, "/CacheService/JSONType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) JSONLen(ctx context.Context, in *JSONPathRequest, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/CacheService/JSONLen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) TSCreate(ctx context.Context, in *TSCreateRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/TSCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) TSAdd(ctx context.Context, in *TSAddRequest, opts ...grpc.CallOption) (*Sample, error) {
+	out := new(Sample)
+	err := c.cc.Invoke(ctx, "/CacheService/TSAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) TSGet(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Sample, error) {
+	out := new(Sample)
+	err := c.cc.Invoke(ctx, "/CacheService/TSGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) TSRange(ctx context.Context, in *TSRangeRequest, opts ...grpc.CallOption) (*Samples, error) {
+	out := new(Samples)
+	err := c.cc.Invoke(ctx, "/CacheService/TSRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) TSCreateRule(ctx context.Context, in *TSRuleRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/TSCreateRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) TSDeleteRule(ctx context.Context, in *TSRuleRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/TSDeleteRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) TSInfo(ctx context.Context, in *Key, opts ...grpc.CallOption) (*TSInfoResult, error) {
+	out := new(TSInfoResult)
+	err := c.cc.Invoke(ctx, "/CacheService/TSInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) TSQueryIndex(ctx context.Context, in *TSLabelFilter, opts ...grpc.CallOption) (*Keys, error) {
+	out := new(Keys)
+	err := c.cc.Invoke(ctx, "/CacheService/TSQueryIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) VCreate(ctx context.Context, in *VectorCreateRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/VCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) VAdd(ctx context.Context, in *VectorAddRequest, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/CacheService/VAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) VDel(ctx context.Context, in *VectorIDs, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/CacheService/VDel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) VGet(ctx context.Context, in *VectorIDs, opts ...grpc.CallOption) (*Vectors, error) {
+	out := new(Vectors)
+	err := c.cc.Invoke(ctx, "/CacheService/VGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) VSearch(ctx context.Context, in *VectorSearchRequest, opts ...grpc.CallOption) (*VectorResults, error) {
+	out := new(VectorResults)
+	err := c.cc.Invoke(ctx, "/CacheService/VSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) VInfo(ctx context.Context, in *Key, opts ...grpc.CallOption) (*VectorInfo, error) {
+	out := new(VectorInfo)
+	err := c.cc.Invoke(ctx, "/CacheService/VInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) CreateIndex(ctx context.Context, in *IndexDefinition, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/CreateIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) DropIndex(ctx context.Context, in *IndexName, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/DropIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) IndexInfo(ctx context.Context, in *IndexName, opts ...grpc.CallOption) (*IndexInfoResult, error) {
+	out := new(IndexInfoResult)
+	err := c.cc.Invoke(ctx, "/CacheService/IndexInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) QueryIndex(ctx context.Context, in *IndexQuery, opts ...grpc.CallOption) (*IndexQueryResult, error) {
+	out := new(IndexQueryResult)
+	err := c.cc.Invoke(ctx, "/CacheService/QueryIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) FTCreate(ctx context.Context, in *SearchIndexDefinition, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/FTCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) FTDrop(ctx context.Context, in *IndexName, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, "/CacheService/FTDrop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) FTInfo(ctx context.Context, in *IndexName, opts ...grpc.CallOption) (*SearchIndexInfo, error) {
+	out := new(SearchIndexInfo)
+	err := c.cc.Invoke(ctx, "/CacheService/FTInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cacheServiceClient) FTSearch(ctx context.Context, in *SearchQuery, opts ...grpc.CallOption) (*SearchResults, error) {
+	out := new(SearchResults)
+	err := c.cc.Invoke(ctx, "/CacheService/FTSearch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CacheServiceServer is the server API for CacheService service.
+// All implementations must embed UnimplementedCacheServiceServer
+// for forward compatibility
+type CacheServiceServer interface {
+	Set(context.Context, *String) (*Response, error)
+	Get(context.Context, *Key) (*String, error)
+	SetCond(context.Context, *SetItem) (*SetResult, error)
+	GetSet(context.Context, *String) (*String, error)
+	DeleteKey(context.Context, *Key) (*Response, error)
+	IncrBy(context.Context, *CounterRequest) (*Count, error)
+	LPush(context.Context, *String) (*Response, error)
+	RPush(context.Context, *String) (*Response, error)
+	GetList(context.Context, *Key) (*List, error)
+	HMSet(context.Context, *HashMapItem) (*Response, error)
+	GetHashMap(context.Context, *Key) (*List, error)
+	SAdd(context.Context, *SetMembersRequest) (*Count, error)
+	SRem(context.Context, *SetMembersRequest) (*Count, error)
+	SMembers(context.Context, *Key) (*List, error)
+	DeleteAll(context.Context, *emptypb.Empty) (*Response, error)
+	AcquireLock(context.Context, *LockRequest) (*Lock, error)
+	RenewLock(context.Context, *LockRequest) (*Lock, error)
+	ReleaseLock(context.Context, *Lock) (*Response, error)
+	Publish(context.Context, *Message) (*PublishResult, error)
+	Subscribe(*SubscribeRequest, CacheService_SubscribeServer) error
+	Watch(*WatchRequest, CacheService_WatchServer) error
+	XAdd(context.Context, *StreamAddRequest) (*StreamID, error)
+	XLen(context.Context, *Key) (*Count, error)
+	XRange(context.Context, *StreamRangeRequest) (*StreamEntries, error)
+	XTrim(context.Context, *StreamTrimRequest) (*Count, error)
+	XRead(context.Context, *StreamReadRequest) (*StreamEntries, error)
+	XGroupCreate(context.Context, *StreamGroupRequest) (*Response, error)
+	XReadGroup(context.Context, *StreamReadGroupRequest) (*StreamEntries, error)
+	XAck(context.Context, *StreamAckRequest) (*Count, error)
+	XPending(context.Context, *StreamPendingRequest) (*StreamPendingList, error)
+	XClaim(context.Context, *StreamClaimRequest) (*StreamEntries, error)
+	PFAdd(context.Context, *HLLAddRequest) (*Response, error)
+	PFCount(context.Context, *Keys) (*Count, error)
+	PFMerge(context.Context, *HLLMergeRequest) (*Response, error)
+	BFReserve(context.Context, *BloomReserveRequest) (*Response, error)
+	BFAdd(context.Context, *FilterItems) (*Results, error)
+	BFExists(context.Context, *FilterItems) (*Results, error)
+	BFInfo(context.Context, *Key) (*FilterInfo, error)
+	CFReserve(context.Context, *CuckooReserveRequest) (*Response, error)
+	CFAdd(context.Context, *FilterItems) (*Results, error)
+	CFExists(context.Context, *FilterItems) (*Results, error)
+	CFDel(context.Context, *FilterItems) (*Results, error)
+	CFInfo(context.Context, *Key) (*FilterInfo, error)
+	CMSInit(context.Context, *CMSInitRequest) (*Response, error)
+	CMSIncrBy(context.Context, *IncrRequest) (*Counts, error)
+	CMSQuery(context.Context, *FilterItems) (*Counts, error)
+	CMSMerge(context.Context, *CMSMergeRequest) (*Response, error)
+	CMSInfo(context.Context, *Key) (*SketchInfo, error)
+	TopKReserve(context.Context, *TopKReserveRequest) (*Response, error)
+	TopKIncrBy(context.Context, *IncrRequest) (*ItemList, error)
+	TopKQuery(context.Context, *FilterItems) (*Results, error)
+	TopKList(context.Context, *Key) (*TopKItems, error)
+	SetBit(context.Context, *BitRequest) (*Count, error)
+	GetBit(context.Context, *BitRequest) (*Count, error)
+	BitCount(context.Context, *BitCountRequest) (*Count, error)
+	BitPos(context.Context, *BitPosRequest) (*Count, error)
+	BitOp(context.Context, *BitOpRequest) (*Count, error)
+	BitField(context.Context, *BitFieldRequest) (*BitFieldResult, error)
+	GeoAdd(context.Context, *GeoAddRequest) (*Count, error)
+	GeoRem(context.Context, *GeoMembersRequest) (*Count, error)
+	GeoPos(context.Context, *GeoMembersRequest) (*GeoPositions, error)
+	GeoDist(context.Context, *GeoDistRequest) (*GeoDistance, error)
+	GeoSearch(context.Context, *GeoSearchRequest) (*GeoResults, error)
+	JSONSet(context.Context, *JSONSetRequest) (*Response, error)
+	JSONGet(context.Context, *JSONPathRequest) (*JSONValue, error)
+	JSONDel(context.Context, *JSONPathRequest) (*Count, error)
+	JSONNumIncrBy(context.Context, *JSONNumRequest) (*JSONValue, error)
+	JSONArrAppend(context.Context, *JSONArrRequest) (*Count, error)
+	JSONArrInsert(context.Context, *JSONArrRequest) (*Count, error)
+	JSONArrPop(context.Context, *JSONArrRequest) (*JSONValue, error)
+	JSONType(context.Context, *JSONPathRequest) (*JSONValue, error)
+	JSONLen(context.Context, *JSONPathRequest) (*Count, error)
+	TSCreate(context.Context, *TSCreateRequest) (*Response, error)
+	TSAdd(context.Context, *TSAddRequest) (*Sample, error)
+	TSGet(context.Context, *Key) (*Sample, error)
+	TSRange(context.Context, *TSRangeRequest) (*Samples, error)
+	TSCreateRule(context.Context, *TSRuleRequest) (*Response, error)
+	TSDeleteRule(context.Context, *TSRuleRequest) (*Response, error)
+	TSInfo(context.Context, *Key) (*TSInfoResult, error)
+	TSQueryIndex(context.Context, *TSLabelFilter) (*Keys, error)
+	VCreate(context.Context, *VectorCreateRequest) (*Response, error)
+	VAdd(context.Context, *VectorAddRequest) (*Count, error)
+	VDel(context.Context, *VectorIDs) (*Count, error)
+	VGet(context.Context, *VectorIDs) (*Vectors, error)
+	VSearch(context.Context, *VectorSearchRequest) (*VectorResults, error)
+	VInfo(context.Context, *Key) (*VectorInfo, error)
+	CreateIndex(context.Context, *IndexDefinition) (*Response, error)
+	DropIndex(context.Context, *IndexName) (*Response, error)
+	IndexInfo(context.Context, *IndexName) (*IndexInfoResult, error)
+	QueryIndex(context.Context, *IndexQuery) (*IndexQueryResult, error)
+	FTCreate(context.Context, *SearchIndexDefinition) (*Response, error)
+	FTDrop(context.Context, *IndexName) (*Response, error)
+	FTInfo(context.Context, *IndexName) (*SearchIndexInfo, error)
+	FTSearch(context.Context, *SearchQuery) (*SearchResults, error)
+	mustEmbedUnimplementedCacheServiceServer()
+}
+
+// UnimplementedCacheServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCacheServiceServer struct {
+}
+
+func (UnimplementedCacheServiceServer) Set(context.Context, *String) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (UnimplementedCacheServiceServer) Get(context.Context, *Key) (*String, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedCacheServiceServer) SetCond(context.Context, *SetItem) (*SetResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCond not implemented")
+}
+func (UnimplementedCacheServiceServer) GetSet(context.Context, *String) (*String, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSet not implemented")
+}
+func (UnimplementedCacheServiceServer) DeleteKey(context.Context, *Key) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteKey not implemented")
+}
+func (UnimplementedCacheServiceServer) IncrBy(context.Context, *CounterRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncrBy not implemented")
+}
+func (UnimplementedCacheServiceServer) LPush(context.Context, *String) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LPush not implemented")
+}
+func (UnimplementedCacheServiceServer) RPush(context.Context, *String) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RPush not implemented")
+}
+func (UnimplementedCacheServiceServer) GetList(context.Context, *Key) (*List, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+}
+func (UnimplementedCacheServiceServer) HMSet(context.Context, *HashMapItem) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HMSet not implemented")
+}
+func (UnimplementedCacheServiceServer) GetHashMap(context.Context, *Key) (*List, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHashMap not implemented")
+}
+func (UnimplementedCacheServiceServer) SAdd(context.Context, *SetMembersRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SAdd not implemented")
+}
+func (UnimplementedCacheServiceServer) SRem(context.Context, *SetMembersRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SRem not implemented")
+}
+func (UnimplementedCacheServiceServer) SMembers(context.Context, *Key) (*List, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SMembers not implemented")
+}
+func (UnimplementedCacheServiceServer) DeleteAll(context.Context, *emptypb.Empty) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAll not implemented")
+}
+func (UnimplementedCacheServiceServer) AcquireLock(context.Context, *LockRequest) (*Lock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcquireLock not implemented")
+}
+func (UnimplementedCacheServiceServer) RenewLock(context.Context, *LockRequest) (*Lock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewLock not implemented")
+}
+func (UnimplementedCacheServiceServer) ReleaseLock(context.Context, *Lock) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseLock not implemented")
+}
+func (UnimplementedCacheServiceServer) Publish(context.Context, *Message) (*PublishResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+}
+func (UnimplementedCacheServiceServer) Subscribe(*SubscribeRequest, CacheService_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedCacheServiceServer) Watch(*WatchRequest, CacheService_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedCacheServiceServer) XAdd(context.Context, *StreamAddRequest) (*StreamID, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XAdd not implemented")
+}
+func (UnimplementedCacheServiceServer) XLen(context.Context, *Key) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XLen not implemented")
+}
+func (UnimplementedCacheServiceServer) XRange(context.Context, *StreamRangeRequest) (*StreamEntries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XRange not implemented")
+}
+func (UnimplementedCacheServiceServer) XTrim(context.Context, *StreamTrimRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XTrim not implemented")
+}
+func (UnimplementedCacheServiceServer) XRead(context.Context, *StreamReadRequest) (*StreamEntries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XRead not implemented")
+}
+func (UnimplementedCacheServiceServer) XGroupCreate(context.Context, *StreamGroupRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XGroupCreate not implemented")
+}
+func (UnimplementedCacheServiceServer) XReadGroup(context.Context, *StreamReadGroupRequest) (*StreamEntries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XReadGroup not implemented")
+}
+func (UnimplementedCacheServiceServer) XAck(context.Context, *StreamAckRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XAck not implemented")
+}
+func (UnimplementedCacheServiceServer) XPending(context.Context, *StreamPendingRequest) (*StreamPendingList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XPending not implemented")
+}
+func (UnimplementedCacheServiceServer) XClaim(context.Context, *StreamClaimRequest) (*StreamEntries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method XClaim not implemented")
+}
+func (UnimplementedCacheServiceServer) PFAdd(context.Context, *HLLAddRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PFAdd not implemented")
+}
+func (UnimplementedCacheServiceServer) PFCount(context.Context, *Keys) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PFCount not implemented")
+}
+func (UnimplementedCacheServiceServer) PFMerge(context.Context, *HLLMergeRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PFMerge not implemented")
+}
+func (UnimplementedCacheServiceServer) BFReserve(context.Context, *BloomReserveRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BFReserve not implemented")
+}
+func (UnimplementedCacheServiceServer) BFAdd(context.Context, *FilterItems) (*Results, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BFAdd not implemented")
+}
+func (UnimplementedCacheServiceServer) BFExists(context.Context, *FilterItems) (*Results, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BFExists not implemented")
+}
+func (UnimplementedCacheServiceServer) BFInfo(context.Context, *Key) (*FilterInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BFInfo not implemented")
+}
+func (UnimplementedCacheServiceServer) CFReserve(context.Context, *CuckooReserveRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CFReserve not implemented")
+}
+func (UnimplementedCacheServiceServer) CFAdd(context.Context, *FilterItems) (*Results, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CFAdd not implemented")
+}
+func (UnimplementedCacheServiceServer) CFExists(context.Context, *FilterItems) (*Results, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CFExists not implemented")
+}
+func (UnimplementedCacheServiceServer) CFDel(context.Context, *FilterItems) (*Results, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CFDel not implemented")
+}
+func (UnimplementedCacheServiceServer) CFInfo(context.Context, *Key) (*FilterInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CFInfo not implemented")
+}
+func (UnimplementedCacheServiceServer) CMSInit(context.Context, *CMSInitRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CMSInit not implemented")
+}
+func (UnimplementedCacheServiceServer) CMSIncrBy(context.Context, *IncrRequest) (*Counts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CMSIncrBy not implemented")
+}
+func (UnimplementedCacheServiceServer) CMSQuery(context.Context, *FilterItems) (*Counts, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CMSQuery not implemented")
+}
+func (UnimplementedCacheServiceServer) CMSMerge(context.Context, *CMSMergeRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CMSMerge not implemented")
+}
+func (UnimplementedCacheServiceServer) CMSInfo(context.Context, *Key) (*SketchInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CMSInfo not implemented")
+}
+func (UnimplementedCacheServiceServer) TopKReserve(context.Context, *TopKReserveRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopKReserve not implemented")
+}
+func (UnimplementedCacheServiceServer) TopKIncrBy(context.Context, *IncrRequest) (*ItemList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopKIncrBy not implemented")
+}
+func (UnimplementedCacheServiceServer) TopKQuery(context.Context, *FilterItems) (*Results, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopKQuery not implemented")
+}
+func (UnimplementedCacheServiceServer) TopKList(context.Context, *Key) (*TopKItems, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopKList not implemented")
+}
+func (UnimplementedCacheServiceServer) SetBit(context.Context, *BitRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBit not implemented")
+}
+func (UnimplementedCacheServiceServer) GetBit(context.Context, *BitRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBit not implemented")
+}
+func (UnimplementedCacheServiceServer) BitCount(context.Context, *BitCountRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BitCount not implemented")
+}
+func (UnimplementedCacheServiceServer) BitPos(context.Context, *BitPosRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BitPos not implemented")
+}
+func (UnimplementedCacheServiceServer) BitOp(context.Context, *BitOpRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BitOp not implemented")
+}
+func (UnimplementedCacheServiceServer) BitField(context.Context, *BitFieldRequest) (*BitFieldResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BitField not implemented")
+}
+func (UnimplementedCacheServiceServer) GeoAdd(context.Context, *GeoAddRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeoAdd not implemented")
+}
+func (UnimplementedCacheServiceServer) GeoRem(context.Context, *GeoMembersRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeoRem not implemented")
+}
+func (UnimplementedCacheServiceServer) GeoPos(context.Context, *GeoMembersRequest) (*GeoPositions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeoPos not implemented")
+}
+func (UnimplementedCacheServiceServer) GeoDist(context.Context, *GeoDistRequest) (*GeoDistance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeoDist not implemented")
+}
+func (UnimplementedCacheServiceServer) GeoSearch(context.Context, *GeoSearchRequest) (*GeoResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GeoSearch not implemented")
+}
+func (UnimplementedCacheServiceServer) JSONSet(context.Context, *JSONSetRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JSONSet not implemented")
+}
+func (UnimplementedCacheServiceServer) JSONGet(context.Context, *JSONPathRequest) (*JSONValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JSONGet not implemented")
+}
+func (UnimplementedCacheServiceServer) JSONDel(context.Context, *JSONPathRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JSONDel not implemented")
+}
+func (UnimplementedCacheServiceServer) JSONNumIncrBy(context.Context, *JSONNumRequest) (*JSONValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JSONNumIncrBy not implemented")
+}
+func (UnimplementedCacheServiceServer) JSONArrAppend(context.Context, *JSONArrRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JSONArrAppend not implemented")
+}
+func (UnimplementedCacheServiceServer) JSONArrInsert(context.Context, *JSONArrRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JSONArrInsert not implemented")
+}
+func (UnimplementedCacheServiceServer) JSONArrPop(context.Context, *JSONArrRequest) (*JSONValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JSONArrPop not implemented")
+}
+func (UnimplementedCacheServiceServer) JSONType(context.Context, *JSONPathRequest) (*JSONValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JSONType not implemented")
+}
+func (UnimplementedCacheServiceServer) JSONLen(context.Context, *JSONPathRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JSONLen not implemented")
+}
+func (UnimplementedCacheServiceServer) TSCreate(context.Context, *TSCreateRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSCreate not implemented")
+}
+func (UnimplementedCacheServiceServer) TSAdd(context.Context, *TSAddRequest) (*Sample, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSAdd not implemented")
+}
+func (UnimplementedCacheServiceServer) TSGet(context.Context, *Key) (*Sample, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSGet not implemented")
+}
+func (UnimplementedCacheServiceServer) TSRange(context.Context, *TSRangeRequest) (*Samples, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSRange not implemented")
+}
+func (UnimplementedCacheServiceServer) TSCreateRule(context.Context, *TSRuleRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSCreateRule not implemented")
+}
+func (UnimplementedCacheServiceServer) TSDeleteRule(context.Context, *TSRuleRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSDeleteRule not implemented")
+}
+func (UnimplementedCacheServiceServer) TSInfo(context.Context, *Key) (*TSInfoResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSInfo not implemented")
+}
+func (UnimplementedCacheServiceServer) TSQueryIndex(context.Context, *TSLabelFilter) (*Keys, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TSQueryIndex not implemented")
+}
+func (UnimplementedCacheServiceServer) VCreate(context.Context, *VectorCreateRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VCreate not implemented")
+}
+func (UnimplementedCacheServiceServer) VAdd(context.Context, *VectorAddRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VAdd not implemented")
+}
+func (UnimplementedCacheServiceServer) VDel(context.Context, *VectorIDs) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VDel not implemented")
+}
+func (UnimplementedCacheServiceServer) VGet(context.Context, *VectorIDs) (*Vectors, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VGet not implemented")
+}
+func (UnimplementedCacheServiceServer) VSearch(context.Context, *VectorSearchRequest) (*VectorResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VSearch not implemented")
+}
+func (UnimplementedCacheServiceServer) VInfo(context.Context, *Key) (*VectorInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VInfo not implemented")
+}
+func (UnimplementedCacheServiceServer) CreateIndex(context.Context, *IndexDefinition) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIndex not implemented")
+}
+func (UnimplementedCacheServiceServer) DropIndex(context.Context, *IndexName) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropIndex not implemented")
+}
+func (UnimplementedCacheServiceServer) IndexInfo(context.Context, *IndexName) (*IndexInfoResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexInfo not implemented")
+}
+func (UnimplementedCacheServiceServer) QueryIndex(context.Context, *IndexQuery) (*IndexQueryResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryIndex not implemented")
+}
+func (UnimplementedCacheServiceServer) FTCreate(context.Context, *SearchIndexDefinition) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FTCreate not implemented")
+}
+func (UnimplementedCacheServiceServer) FTDrop(context.Context, *IndexName) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FTDrop not implemented")
+}
+func (UnimplementedCacheServiceServer) FTInfo(context.Context, *IndexName) (*SearchIndexInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FTInfo not implemented")
+}
+func (UnimplementedCacheServiceServer) FTSearch(context.Context, *SearchQuery) (*SearchResults, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FTSearch not implemented")
+}
+func (UnimplementedCacheServiceServer) mustEmbedUnimplementedCacheServiceServer() {}
+
+// UnsafeCacheServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CacheServiceServer will
+// result in compilation errors.
+type UnsafeCacheServiceServer interface {
+	mustEmbedUnimplementedCacheServiceServer()
+}
+
+func RegisterCacheServiceServer(s grpc.ServiceRegistrar, srv CacheServiceServer) {
+	s.RegisterService(&CacheService_ServiceDesc, srv)
+}
+
+func _CacheService_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(String)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/Set",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).Set(ctx, req.(*String))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).Get(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_SetCond_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).SetCond(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/SetCond",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).SetCond(ctx, req.(*SetItem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(String)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/GetSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetSet(ctx, req.(*String))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/DeleteKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteKey(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_IncrBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CounterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).IncrBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/IncrBy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).IncrBy(ctx, req.(*CounterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_LPush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(String)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).LPush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/LPush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).LPush(ctx, req.(*String))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_RPush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(String)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).RPush(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/RPush",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).RPush(ctx, req.(*String))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/GetList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetList(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_HMSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HashMapItem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).HMSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/HMSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).HMSet(ctx, req.(*HashMapItem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetHashMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetHashMap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/GetHashMap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetHashMap(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_SAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).SAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/SAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).SAdd(ctx, req.(*SetMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_SRem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).SRem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/SRem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).SRem(ctx, req.(*SetMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_SMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).SMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/SMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).SMembers(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DeleteAll(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/DeleteAll",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DeleteAll(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_AcquireLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).AcquireLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/AcquireLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).AcquireLock(ctx, req.(*LockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_RenewLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).RenewLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/RenewLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).RenewLock(ctx, req.(*LockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_ReleaseLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Lock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).ReleaseLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/ReleaseLock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).ReleaseLock(ctx, req.(*Lock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Message)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).Publish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/Publish",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).Publish(ctx, req.(*Message))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServiceServer).Subscribe(m, &cacheServiceSubscribeServer{stream})
+}
+
+type CacheService_SubscribeServer interface {
+	Send(*Message) error
+	grpc.ServerStream
+}
+
+type cacheServiceSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *cacheServiceSubscribeServer) Send(m *Message) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CacheService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CacheServiceServer).Watch(m, &cacheServiceWatchServer{stream})
+}
+
+type CacheService_WatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type cacheServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *cacheServiceWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CacheService_XAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).XAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/XAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).XAdd(ctx, req.(*StreamAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_XLen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).XLen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/XLen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).XLen(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_XRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).XRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/XRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).XRange(ctx, req.(*StreamRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_XTrim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamTrimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).XTrim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/XTrim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).XTrim(ctx, req.(*StreamTrimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_XRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).XRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/XRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).XRead(ctx, req.(*StreamReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_XGroupCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).XGroupCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/XGroupCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).XGroupCreate(ctx, req.(*StreamGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_XReadGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamReadGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).XReadGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/XReadGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).XReadGroup(ctx, req.(*StreamReadGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_XAck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamAckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).XAck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/XAck",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).XAck(ctx, req.(*StreamAckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_XPending_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamPendingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).XPending(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/XPending",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).XPending(ctx, req.(*StreamPendingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_XClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamClaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).XClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/XClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).XClaim(ctx, req.(*StreamClaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PFAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HLLAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PFAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/PFAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PFAdd(ctx, req.(*HLLAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PFCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Keys)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PFCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/PFCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PFCount(ctx, req.(*Keys))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_PFMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HLLMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).PFMerge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/PFMerge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).PFMerge(ctx, req.(*HLLMergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_BFReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BloomReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).BFReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/BFReserve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).BFReserve(ctx, req.(*BloomReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_BFAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterItems)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).BFAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/BFAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).BFAdd(ctx, req.(*FilterItems))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_BFExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterItems)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).BFExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/BFExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).BFExists(ctx, req.(*FilterItems))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_BFInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).BFInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/BFInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).BFInfo(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_CFReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CuckooReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).CFReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/CFReserve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).CFReserve(ctx, req.(*CuckooReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_CFAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterItems)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).CFAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/CFAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).CFAdd(ctx, req.(*FilterItems))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_CFExists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterItems)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).CFExists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/CFExists",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).CFExists(ctx, req.(*FilterItems))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_CFDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterItems)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).CFDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/CFDel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).CFDel(ctx, req.(*FilterItems))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_CFInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).CFInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/CFInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).CFInfo(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_CMSInit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CMSInitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).CMSInit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/CMSInit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).CMSInit(ctx, req.(*CMSInitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_CMSIncrBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).CMSIncrBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/CMSIncrBy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).CMSIncrBy(ctx, req.(*IncrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_CMSQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterItems)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).CMSQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/CMSQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).CMSQuery(ctx, req.(*FilterItems))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_CMSMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CMSMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).CMSMerge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/CMSMerge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).CMSMerge(ctx, req.(*CMSMergeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_CMSInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).CMSInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/CMSInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).CMSInfo(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_TopKReserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopKReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).TopKReserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/TopKReserve",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).TopKReserve(ctx, req.(*TopKReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_TopKIncrBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IncrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).TopKIncrBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/TopKIncrBy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).TopKIncrBy(ctx, req.(*IncrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_TopKQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterItems)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).TopKQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/TopKQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).TopKQuery(ctx, req.(*FilterItems))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_TopKList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).TopKList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/TopKList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).TopKList(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_SetBit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).SetBit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/SetBit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).SetBit(ctx, req.(*BitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GetBit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GetBit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/GetBit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GetBit(ctx, req.(*BitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_BitCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BitCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).BitCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/BitCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).BitCount(ctx, req.(*BitCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_BitPos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BitPosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).BitPos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/BitPos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).BitPos(ctx, req.(*BitPosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_BitOp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BitOpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).BitOp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/BitOp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).BitOp(ctx, req.(*BitOpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_BitField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BitFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).BitField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/BitField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).BitField(ctx, req.(*BitFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GeoAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeoAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GeoAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/GeoAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GeoAdd(ctx, req.(*GeoAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GeoRem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeoMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GeoRem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/GeoRem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GeoRem(ctx, req.(*GeoMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GeoPos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeoMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GeoPos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/GeoPos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GeoPos(ctx, req.(*GeoMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GeoDist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeoDistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GeoDist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/GeoDist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GeoDist(ctx, req.(*GeoDistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_GeoSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GeoSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).GeoSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/GeoSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).GeoSearch(ctx, req.(*GeoSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_JSONSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).JSONSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/JSONSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).JSONSet(ctx, req.(*JSONSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_JSONGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).JSONGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/JSONGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).JSONGet(ctx, req.(*JSONPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_JSONDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).JSONDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/JSONDel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).JSONDel(ctx, req.(*JSONPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_JSONNumIncrBy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONNumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).JSONNumIncrBy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/JSONNumIncrBy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).JSONNumIncrBy(ctx, req.(*JSONNumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_JSONArrAppend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONArrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).JSONArrAppend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/JSONArrAppend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).JSONArrAppend(ctx, req.(*JSONArrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_JSONArrInsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONArrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).JSONArrInsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/JSONArrInsert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).JSONArrInsert(ctx, req.(*JSONArrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_JSONArrPop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONArrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).JSONArrPop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/JSONArrPop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).JSONArrPop(ctx, req.(*JSONArrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_JSONType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).JSONType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/JSONType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).JSONType(ctx, req.(*JSONPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_JSONLen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JSONPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).JSONLen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/JSONLen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).JSONLen(ctx, req.(*JSONPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_TSCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TSCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).TSCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/TSCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).TSCreate(ctx, req.(*TSCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_TSAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TSAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).TSAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/TSAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).TSAdd(ctx, req.(*TSAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_TSGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).TSGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/TSGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).TSGet(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_TSRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TSRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).TSRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/TSRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).TSRange(ctx, req.(*TSRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_TSCreateRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TSRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).TSCreateRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/TSCreateRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).TSCreateRule(ctx, req.(*TSRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_TSDeleteRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TSRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).TSDeleteRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/TSDeleteRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).TSDeleteRule(ctx, req.(*TSRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_TSInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).TSInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/TSInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).TSInfo(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_TSQueryIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TSLabelFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).TSQueryIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/TSQueryIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).TSQueryIndex(ctx, req.(*TSLabelFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_VCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VectorCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).VCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/VCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).VCreate(ctx, req.(*VectorCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_VAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VectorAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).VAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/VAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).VAdd(ctx, req.(*VectorAddRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_VDel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VectorIDs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).VDel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/VDel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).VDel(ctx, req.(*VectorIDs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_VGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VectorIDs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).VGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/VGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).VGet(ctx, req.(*VectorIDs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_VSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VectorSearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).VSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/VSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).VSearch(ctx, req.(*VectorSearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_VInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).VInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/VInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).VInfo(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_CreateIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexDefinition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).CreateIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/CreateIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).CreateIndex(ctx, req.(*IndexDefinition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_DropIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).DropIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/DropIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).DropIndex(ctx, req.(*IndexName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_IndexInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).IndexInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/IndexInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).IndexInfo(ctx, req.(*IndexName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_QueryIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).QueryIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/QueryIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).QueryIndex(ctx, req.(*IndexQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_FTCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchIndexDefinition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).FTCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/FTCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).FTCreate(ctx, req.(*SearchIndexDefinition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_FTDrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).FTDrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/FTDrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).FTDrop(ctx, req.(*IndexName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_FTInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IndexName)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).FTInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/FTInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).FTInfo(ctx, req.(*IndexName))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CacheService_FTSearch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CacheServiceServer).FTSearch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CacheService/FTSearch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CacheServiceServer).FTSearch(ctx, req.(*SearchQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CacheService_ServiceDesc is the grpc.ServiceDesc for CacheService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CacheService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "CacheService",
+	HandlerType: (*CacheServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Set",
+			Handler:    _CacheService_Set_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _CacheService_Get_Handler,
+		},
+		{
+			MethodName: "SetCond",
+			Handler:    _CacheService_SetCond_Handler,
+		},
+		{
+			MethodName: "GetSet",
+			Handler:    _CacheService_GetSet_Handler,
+		},
+		{
+			MethodName: "DeleteKey",
+			Handler:    _CacheService_DeleteKey_Handler,
+		},
+		{
+			MethodName: "IncrBy",
+			Handler:    _CacheService_IncrBy_Handler,
+		},
+		{
+			MethodName: "LPush",
+			Handler:    _CacheService_LPush_Handler,
+		},
+		{
+			MethodName: "RPush",
+			Handler:    _CacheService_RPush_Handler,
+		},
+		{
+			MethodName: "GetList",
+			Handler:    _CacheService_GetList_Handler,
+		},
+		{
+			MethodName: "HMSet",
+			Handler:    _CacheService_HMSet_Handler,
+		},
+		{
+			MethodName: "GetHashMap",
+			Handler:    _CacheService_GetHashMap_Handler,
+		},
+		{
+			MethodName: "SAdd",
+			Handler:    _CacheService_SAdd_Handler,
+		},
+		{
+			MethodName: "SRem",
+			Handler:    _CacheService_SRem_Handler,
+		},
+		{
+			MethodName: "SMembers",
+			Handler:    _CacheService_SMembers_Handler,
+		},
+		{
+			MethodName: "DeleteAll",
+			Handler:    _CacheService_DeleteAll_Handler,
+		},
+		{
+			MethodName: "AcquireLock",
+			Handler:    _CacheService_AcquireLock_Handler,
+		},
+		{
+			MethodName: "RenewLock",
+			Handler:    _CacheService_RenewLock_Handler,
+		},
+		{
+			MethodName: "ReleaseLock",
+			Handler:    _CacheService_ReleaseLock_Handler,
+		},
+		{
+			MethodName: "Publish",
+			Handler:    _CacheService_Publish_Handler,
+		},
+		{
+			MethodName: "XAdd",
+			Handler:    _CacheService_XAdd_Handler,
+		},
+		{
+			MethodName: "XLen",
+			Handler:    _CacheService_XLen_Handler,
+		},
+		{
+			MethodName: "XRange",
+			Handler:    _CacheService_XRange_Handler,
+		},
+		{
+			MethodName: "XTrim",
+			Handler:    _CacheService_XTrim_Handler,
+		},
+		{
+			MethodName: "XRead",
+			Handler:    _CacheService_XRead_Handler,
+		},
+		{
+			MethodName: "XGroupCreate",
+			Handler:    _CacheService_XGroupCreate_Handler,
+		},
+		{
+			MethodName: "XReadGroup",
+			Handler:    _CacheService_XReadGroup_Handler,
+		},
+		{
+			MethodName: "XAck",
+			Handler:    _CacheService_XAck_Handler,
+		},
+		{
+			MethodName: "XPending",
+			Handler:    _CacheService_XPending_Handler,
+		},
+		{
+			MethodName: "XClaim",
+			Handler:    _CacheService_XClaim_Handler,
+		},
+		{
+			MethodName: "PFAdd",
+			Handler:    _CacheService_PFAdd_Handler,
+		},
+		{
+			MethodName: "PFCount",
+			Handler:    _CacheService_PFCount_Handler,
+		},
+		{
+			MethodName: "PFMerge",
+			Handler:    _CacheService_PFMerge_Handler,
+		},
+		{
+			MethodName: "BFReserve",
+			Handler:    _CacheService_BFReserve_Handler,
+		},
+		{
+			MethodName: "BFAdd",
+			Handler:    _CacheService_BFAdd_Handler,
+		},
+		{
+			MethodName: "BFExists",
+			Handler:    _CacheService_BFExists_Handler,
+		},
+		{
+			MethodName: "BFInfo",
+			Handler:    _CacheService_BFInfo_Handler,
+		},
+		{
+			MethodName: "CFReserve",
+			Handler:    _CacheService_CFReserve_Handler,
+		},
+		{
+			MethodName: "CFAdd",
+			Handler:    _CacheService_CFAdd_Handler,
+		},
+		{
+			MethodName: "CFExists",
+			Handler:    _CacheService_CFExists_Handler,
+		},
+		{
+			MethodName: "CFDel",
+			Handler:    _CacheService_CFDel_Handler,
+		},
+		{
+			MethodName: "CFInfo",
+			Handler:    _CacheService_CFInfo_Handler,
+		},
+		{
+			MethodName: "CMSInit",
+			Handler:    _CacheService_CMSInit_Handler,
+		},
+		{
+			MethodName: "CMSIncrBy",
+			Handler:    _CacheService_CMSIncrBy_Handler,
+		},
+		{
+			MethodName: "CMSQuery",
+			Handler:    _CacheService_CMSQuery_Handler,
+		},
+		{
+			MethodName: "CMSMerge",
+			Handler:    _CacheService_CMSMerge_Handler,
+		},
+		{
+			MethodName: "CMSInfo",
+			Handler:    _CacheService_CMSInfo_Handler,
+		},
+		{
+			MethodName: "TopKReserve",
+			Handler:    _CacheService_TopKReserve_Handler,
+		},
+		{
+			MethodName: "TopKIncrBy",
+			Handler:    _CacheService_TopKIncrBy_Handler,
+		},
+		{
+			MethodName: "TopKQuery",
+			Handler:    _CacheService_TopKQuery_Handler,
+		},
+		{
+			MethodName: "TopKList",
+			Handler:    _CacheService_TopKList_Handler,
+		},
+		{
+			MethodName: "SetBit",
+			Handler:    _CacheService_SetBit_Handler,
+		},
+		{
+			MethodName: "GetBit",
+			Handler:    _CacheService_GetBit_Handler,
+		},
+		{
+			MethodName: "BitCount",
+			Handler:    _CacheService_BitCount_Handler,
+		},
+		{
+			MethodName: "BitPos",
+			Handler:    _CacheService_BitPos_Handler,
+		},
+		{
+			MethodName: "BitOp",
+			Handler:    _CacheService_BitOp_Handler,
+		},
+		{
+			MethodName: "BitField",
+			Handler:    _CacheService_BitField_Handler,
+		},
+		{
+			MethodName: "GeoAdd",
+			Handler:    _CacheService_GeoAdd_Handler,
+		},
+		{
+			MethodName: "GeoRem",
+			Handler:    _CacheService_GeoRem_Handler,
+		},
+		{
+			MethodName: "GeoPos",
+			Handler:    _CacheService_GeoPos_Handler,
+		},
+		{
+			MethodName: "GeoDist",
+			Handler:    _CacheService_GeoDist_Handler,
+		},
+		{
+			MethodName: "GeoSearch",
+			Handler:    _CacheService_GeoSearch_Handler,
+		},
+		{
+			MethodName: "JSONSet",
+			Handler:    _CacheService_JSONSet_Handler,
+		},
+		{
+			MethodName: "JSONGet",
+			Handler:    _CacheService_JSONGet_Handler,
+		},
+		{
+			MethodName: "JSONDel",
+			Handler:    _CacheService_JSONDel_Handler,
+		},
+		{
+			MethodName: "JSONNumIncrBy",
+			Handler:    _CacheService_JSONNumIncrBy_Handler,
+		},
+		{
+			MethodName: "JSONArrAppend",
+			Handler:    _CacheService_JSONArrAppend_Handler,
+		},
+		{
+			MethodName: "JSONArrInsert",
+			Handler:    _CacheService_JSONArrInsert_Handler,
+		},
+		{
+			MethodName: "JSONArrPop",
+			Handler:    _CacheService_JSONArrPop_Handler,
+		},
+		{
+			MethodName: "JSONType",
+			Handler:    _CacheService_JSONType_Handler,
+		},
+		{
+			MethodName: "JSONLen",
+			Handler:    _CacheService_JSONLen_Handler,
+		},
+		{
+			MethodName: "TSCreate",
+			Handler:    _CacheService_TSCreate_Handler,
+		},
+		{
+			MethodName: "TSAdd",
+			Handler:    _CacheService_TSAdd_Handler,
+		},
+		{
+			MethodName: "TSGet",
+			Handler:    _CacheService_TSGet_Handler,
+		},
+		{
+			MethodName: "TSRange",
+			Handler:    _CacheService_TSRange_Handler,
+		},
+		{
+			MethodName: "TSCreateRule",
+			Handler:    _CacheService_TSCreateRule_Handler,
+		},
+		{
+			MethodName: "TSDeleteRule",
+			Handler:    _CacheService_TSDeleteRule_Handler,
+		},
+		{
+			MethodName: "TSInfo",
+			Handler:    _CacheService_TSInfo_Handler,
+		},
+		{
+			MethodName: "TSQueryIndex",
+			Handler:    _CacheService_TSQueryIndex_Handler,
+		},
+		{
+			MethodName: "VCreate",
+			Handler:    _CacheService_VCreate_Handler,
+		},
+		{
+			MethodName: "VAdd",
+			Handler:    _CacheService_VAdd_Handler,
+		},
+		{
+			MethodName: "VDel",
+			Handler:    _CacheService_VDel_Handler,
+		},
+		{
+			MethodName: "VGet",
+			Handler:    _CacheService_VGet_Handler,
+		},
+		{
+			MethodName: "VSearch",
+			Handler:    _CacheService_VSearch_Handler,
+		},
+		{
+			MethodName: "VInfo",
+			Handler:    _CacheService_VInfo_Handler,
+		},
+		{
+			MethodName: "CreateIndex",
+			Handler:    _CacheService_CreateIndex_Handler,
+		},
+		{
+			MethodName: "DropIndex",
+			Handler:    _CacheService_DropIndex_Handler,
+		},
+		{
+			MethodName: "IndexInfo",
+			Handler:    _CacheService_IndexInfo_Handler,
+		},
+		{
+			MethodName: "QueryIndex",
+			Handler:    _CacheService_QueryIndex_Handler,
+		},
+		{
+			MethodName: "FTCreate",
+			Handler:    _CacheService_FTCreate_Handler,
+		},
+		{
+			MethodName: "FTDrop",
+			Handler:    _CacheService_FTDrop_Handler,
+		},
+		{
+			MethodName: "FTInfo",
+			Handler:    _CacheService_FTInfo_Handler,
+		},
+		{
+			MethodName: "FTSearch",
+			Handler:    _CacheService_FTSearch_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _CacheService_Subscribe_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _CacheService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cash_proto/cash.proto",
+}
+
+// ClusterServiceClient is the client API for ClusterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ClusterServiceClient interface {
+	ClusterInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClusterState, error)
+	ClusterSetSlots(ctx context.Context, in *SetSlotsRequest, opts ...grpc.CallOption) (*ClusterState, error)
+	ClusterKeySlot(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Count, error)
+	ClusterMigrate(ctx context.Context, in *MigrateRequest, opts ...grpc.CallOption) (*MigrationStatus, error)
+	ClusterMigrationStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MigrationStatus, error)
+	ClusterImport(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*Count, error)
+}
+
+type clusterServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewClusterServiceClient(cc grpc.ClientConnInterface) ClusterServiceClient {
+	return &clusterServiceClient{cc}
+}
+
+func (c *clusterServiceClient) ClusterInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClusterState, error) {
+	out := new(ClusterState)
+	err := c.cc.Invoke(ctx, "/ClusterService/ClusterInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ClusterSetSlots(ctx context.Context, in *SetSlotsRequest, opts ...grpc.CallOption) (*ClusterState, error) {
+	out := new(ClusterState)
+	err := c.cc.Invoke(ctx, "/ClusterService/ClusterSetSlots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ClusterKeySlot(ctx context.Context, in *Key, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/ClusterService/ClusterKeySlot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ClusterMigrate(ctx context.Context, in *MigrateRequest, opts ...grpc.CallOption) (*MigrationStatus, error) {
+	out := new(MigrationStatus)
+	err := c.cc.Invoke(ctx, "/ClusterService/ClusterMigrate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ClusterMigrationStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MigrationStatus, error) {
+	out := new(MigrationStatus)
+	err := c.cc.Invoke(ctx, "/ClusterService/ClusterMigrationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ClusterImport(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/ClusterService/ClusterImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ClusterServiceServer is the server API for ClusterService service.
+// All implementations must embed UnimplementedClusterServiceServer
+// for forward compatibility
+type ClusterServiceServer interface {
+	ClusterInfo(context.Context, *emptypb.Empty) (*ClusterState, error)
+	ClusterSetSlots(context.Context, *SetSlotsRequest) (*ClusterState, error)
+	ClusterKeySlot(context.Context, *Key) (*Count, error)
+	ClusterMigrate(context.Context, *MigrateRequest) (*MigrationStatus, error)
+	ClusterMigrationStatus(context.Context, *emptypb.Empty) (*MigrationStatus, error)
+	ClusterImport(context.Context, *ImportRequest) (*Count, error)
+	mustEmbedUnimplementedClusterServiceServer()
+}
+
+// UnimplementedClusterServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedClusterServiceServer struct {
+}
+
+func (UnimplementedClusterServiceServer) ClusterInfo(context.Context, *emptypb.Empty) (*ClusterState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClusterInfo not implemented")
+}
+func (UnimplementedClusterServiceServer) ClusterSetSlots(context.Context, *SetSlotsRequest) (*ClusterState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClusterSetSlots not implemented")
+}
+func (UnimplementedClusterServiceServer) ClusterKeySlot(context.Context, *Key) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClusterKeySlot not implemented")
+}
+func (UnimplementedClusterServiceServer) ClusterMigrate(context.Context, *MigrateRequest) (*MigrationStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClusterMigrate not implemented")
+}
+func (UnimplementedClusterServiceServer) ClusterMigrationStatus(context.Context, *emptypb.Empty) (*MigrationStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClusterMigrationStatus not implemented")
+}
+func (UnimplementedClusterServiceServer) ClusterImport(context.Context, *ImportRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClusterImport not implemented")
+}
+func (UnimplementedClusterServiceServer) mustEmbedUnimplementedClusterServiceServer() {}
+
+// UnsafeClusterServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ClusterServiceServer will
+// result in compilation errors.
+type UnsafeClusterServiceServer interface {
+	mustEmbedUnimplementedClusterServiceServer()
+}
+
+func RegisterClusterServiceServer(s grpc.ServiceRegistrar, srv ClusterServiceServer) {
+	s.RegisterService(&ClusterService_ServiceDesc, srv)
+}
+
+func _ClusterService_ClusterInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ClusterInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ClusterService/ClusterInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ClusterInfo(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ClusterSetSlots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSlotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ClusterSetSlots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ClusterService/ClusterSetSlots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ClusterSetSlots(ctx, req.(*SetSlotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ClusterKeySlot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Key)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ClusterKeySlot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ClusterService/ClusterKeySlot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ClusterKeySlot(ctx, req.(*Key))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ClusterMigrate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ClusterMigrate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ClusterService/ClusterMigrate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ClusterMigrate(ctx, req.(*MigrateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ClusterMigrationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ClusterMigrationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ClusterService/ClusterMigrationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ClusterMigrationStatus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ClusterImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ClusterImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ClusterService/ClusterImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ClusterImport(ctx, req.(*ImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ClusterService_ServiceDesc is the grpc.ServiceDesc for ClusterService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ClusterService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ClusterService",
+	HandlerType: (*ClusterServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ClusterInfo",
+			Handler:    _ClusterService_ClusterInfo_Handler,
+		},
+		{
+			MethodName: "ClusterSetSlots",
+			Handler:    _ClusterService_ClusterSetSlots_Handler,
+		},
+		{
+			MethodName: "ClusterKeySlot",
+			Handler:    _ClusterService_ClusterKeySlot_Handler,
+		},
+		{
+			MethodName: "ClusterMigrate",
+			Handler:    _ClusterService_ClusterMigrate_Handler,
+		},
+		{
+			MethodName: "ClusterMigrationStatus",
+			Handler:    _ClusterService_ClusterMigrationStatus_Handler,
+		},
+		{
+			MethodName: "ClusterImport",
+			Handler:    _ClusterService_ClusterImport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cash_proto/cash.proto",
+}
+
+// RaftServiceClient is the client API for RaftService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RaftServiceClient interface {
+	RequestVote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error)
+	AppendEntries(ctx context.Context, in *AppendRequest, opts ...grpc.CallOption) (*AppendResponse, error)
+	InstallSnapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (*SnapshotResponse, error)
+	RaftStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RaftState, error)
+	RaftAddMember(ctx context.Context, in *RaftMember, opts ...grpc.CallOption) (*RaftState, error)
+	RaftRemoveMember(ctx context.Context, in *RaftMember, opts ...grpc.CallOption) (*RaftState, error)
+}
+
+type raftServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRaftServiceClient(cc grpc.ClientConnInterface) RaftServiceClient {
+	return &raftServiceClient{cc}
+}
+
+func (c *raftServiceClient) RequestVote(ctx context.Context, in *VoteRequest, opts ...grpc.CallOption) (*VoteResponse, error) {
+	out := new(VoteResponse)
+	err := c.cc.Invoke(ctx, "/RaftService/RequestVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftServiceClient) AppendEntries(ctx context.Context, in *AppendRequest, opts ...grpc.CallOption) (*AppendResponse, error) {
+	out := new(AppendResponse)
+	err := c.cc.Invoke(ctx, "/RaftService/AppendEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftServiceClient) InstallSnapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (*SnapshotResponse, error) {
+	out := new(SnapshotResponse)
+	err := c.cc.Invoke(ctx, "/RaftService/InstallSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftServiceClient) RaftStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RaftState, error) {
+	out := new(RaftState)
+	err := c.cc.Invoke(ctx, "/RaftService/RaftStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftServiceClient) RaftAddMember(ctx context.Context, in *RaftMember, opts ...grpc.CallOption) (*RaftState, error) {
+	out := new(RaftState)
+	err := c.cc.Invoke(ctx, "/RaftService/RaftAddMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftServiceClient) RaftRemoveMember(ctx context.Context, in *RaftMember, opts ...grpc.CallOption) (*RaftState, error) {
+	out := new(RaftState)
+	err := c.cc.Invoke(ctx, "/RaftService/RaftRemoveMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RaftServiceServer is the server API for RaftService service.
+// All implementations must embed UnimplementedRaftServiceServer
+// for forward compatibility
+type RaftServiceServer interface {
+	RequestVote(context.Context, *VoteRequest) (*VoteResponse, error)
+	AppendEntries(context.Context, *AppendRequest) (*AppendResponse, error)
+	InstallSnapshot(context.Context, *SnapshotRequest) (*SnapshotResponse, error)
+	RaftStatus(context.Context, *emptypb.Empty) (*RaftState, error)
+	RaftAddMember(context.Context, *RaftMember) (*RaftState, error)
+	RaftRemoveMember(context.Context, *RaftMember) (*RaftState, error)
+	mustEmbedUnimplementedRaftServiceServer()
+}
+
+// UnimplementedRaftServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRaftServiceServer struct {
+}
+
+func (UnimplementedRaftServiceServer) RequestVote(context.Context, *VoteRequest) (*VoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestVote not implemented")
+}
+func (UnimplementedRaftServiceServer) AppendEntries(context.Context, *AppendRequest) (*AppendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendEntries not implemented")
+}
+func (UnimplementedRaftServiceServer) InstallSnapshot(context.Context, *SnapshotRequest) (*SnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstallSnapshot not implemented")
+}
+func (UnimplementedRaftServiceServer) RaftStatus(context.Context, *emptypb.Empty) (*RaftState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RaftStatus not implemented")
+}
+func (UnimplementedRaftServiceServer) RaftAddMember(context.Context, *RaftMember) (*RaftState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RaftAddMember not implemented")
+}
+func (UnimplementedRaftServiceServer) RaftRemoveMember(context.Context, *RaftMember) (*RaftState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RaftRemoveMember not implemented")
+}
+func (UnimplementedRaftServiceServer) mustEmbedUnimplementedRaftServiceServer() {}
+
+// UnsafeRaftServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RaftServiceServer will
+// result in compilation errors.
+type UnsafeRaftServiceServer interface {
+	mustEmbedUnimplementedRaftServiceServer()
+}
+
+func RegisterRaftServiceServer(s grpc.ServiceRegistrar, srv RaftServiceServer) {
+	s.RegisterService(&RaftService_ServiceDesc, srv)
+}
+
+func _RaftService_RequestVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftServiceServer).RequestVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftService/RequestVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftServiceServer).RequestVote(ctx, req.(*VoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftService_AppendEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftServiceServer).AppendEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftService/AppendEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftServiceServer).AppendEntries(ctx, req.(*AppendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftService_InstallSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftServiceServer).InstallSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftService/InstallSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftServiceServer).InstallSnapshot(ctx, req.(*SnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftService_RaftStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftServiceServer).RaftStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftService/RaftStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftServiceServer).RaftStatus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftService_RaftAddMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaftMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftServiceServer).RaftAddMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftService/RaftAddMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftServiceServer).RaftAddMember(ctx, req.(*RaftMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RaftService_RaftRemoveMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RaftMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftServiceServer).RaftRemoveMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/RaftService/RaftRemoveMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftServiceServer).RaftRemoveMember(ctx, req.(*RaftMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RaftService_ServiceDesc is the grpc.ServiceDesc for RaftService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RaftService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "RaftService",
+	HandlerType: (*RaftServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RequestVote",
+			Handler:    _RaftService_RequestVote_Handler,
+		},
+		{
+			MethodName: "AppendEntries",
+			Handler:    _RaftService_AppendEntries_Handler,
+		},
+		{
+			MethodName: "InstallSnapshot",
+			Handler:    _RaftService_InstallSnapshot_Handler,
+		},
+		{
+			MethodName: "RaftStatus",
+			Handler:    _RaftService_RaftStatus_Handler,
+		},
+		{
+			MethodName: "RaftAddMember",
+			Handler:    _RaftService_RaftAddMember_Handler,
+		},
+		{
+			MethodName: "RaftRemoveMember",
+			Handler:    _RaftService_RaftRemoveMember_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cash_proto/cash.proto",
+}
+
+// GossipServiceClient is the client API for GossipService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GossipServiceClient interface {
+	Ping(ctx context.Context, in *GossipPing, opts ...grpc.CallOption) (*GossipAck, error)
+	PingReq(ctx context.Context, in *GossipPingReq, opts ...grpc.CallOption) (*GossipAck, error)
+	Sync(ctx context.Context, in *GossipSync, opts ...grpc.CallOption) (*GossipSync, error)
+	GossipMembers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GossipState, error)
+}
+
+type gossipServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGossipServiceClient(cc grpc.ClientConnInterface) GossipServiceClient {
+	return &gossipServiceClient{cc}
+}
+
+func (c *gossipServiceClient) Ping(ctx context.Context, in *GossipPing, opts ...grpc.CallOption) (*GossipAck, error) {
+	out := new(GossipAck)
+	err := c.cc.Invoke(ctx, "/GossipService/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gossipServiceClient) PingReq(ctx context.Context, in *GossipPingReq, opts ...grpc.CallOption) (*GossipAck, error) {
+	out := new(GossipAck)
+	err := c.cc.Invoke(ctx, "/GossipService/PingReq", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gossipServiceClient) Sync(ctx context.Context, in *GossipSync, opts ...grpc.CallOption) (*GossipSync, error) {
+	out := new(GossipSync)
+	err := c.cc.Invoke(ctx, "/GossipService/Sync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gossipServiceClient) GossipMembers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GossipState, error) {
+	out := new(GossipState)
+	err := c.cc.Invoke(ctx, "/GossipService/GossipMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GossipServiceServer is the server API for GossipService service.
+// All implementations must embed UnimplementedGossipServiceServer
+// for forward compatibility
+type GossipServiceServer interface {
+	Ping(context.Context, *GossipPing) (*GossipAck, error)
+	PingReq(context.Context, *GossipPingReq) (*GossipAck, error)
+	Sync(context.Context, *GossipSync) (*GossipSync, error)
+	GossipMembers(context.Context, *emptypb.Empty) (*GossipState, error)
+	mustEmbedUnimplementedGossipServiceServer()
+}
+
+// UnimplementedGossipServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedGossipServiceServer struct {
+}
+
+func (UnimplementedGossipServiceServer) Ping(context.Context, *GossipPing) (*GossipAck, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedGossipServiceServer) PingReq(context.Context, *GossipPingReq) (*GossipAck, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PingReq not implemented")
+}
+func (UnimplementedGossipServiceServer) Sync(context.Context, *GossipSync) (*GossipSync, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
+}
+func (UnimplementedGossipServiceServer) GossipMembers(context.Context, *emptypb.Empty) (*GossipState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GossipMembers not implemented")
+}
+func (UnimplementedGossipServiceServer) mustEmbedUnimplementedGossipServiceServer() {}
+
+// UnsafeGossipServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GossipServiceServer will
+// result in compilation errors.
+type UnsafeGossipServiceServer interface {
+	mustEmbedUnimplementedGossipServiceServer()
+}
+
+func RegisterGossipServiceServer(s grpc.ServiceRegistrar, srv GossipServiceServer) {
+	s.RegisterService(&GossipService_ServiceDesc, srv)
+}
+
+func _GossipService_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GossipPing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GossipServiceServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GossipService/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GossipServiceServer).Ping(ctx, req.(*GossipPing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GossipService_PingReq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GossipPingReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GossipServiceServer).PingReq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GossipService/PingReq",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GossipServiceServer).PingReq(ctx, req.(*GossipPingReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GossipService_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GossipSync)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GossipServiceServer).Sync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GossipService/Sync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GossipServiceServer).Sync(ctx, req.(*GossipSync))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GossipService_GossipMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GossipServiceServer).GossipMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/GossipService/GossipMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GossipServiceServer).GossipMembers(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GossipService_ServiceDesc is the grpc.ServiceDesc for GossipService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GossipService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "GossipService",
+	HandlerType: (*GossipServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Ping",
+			Handler:    _GossipService_Ping_Handler,
+		},
+		{
+			MethodName: "PingReq",
+			Handler:    _GossipService_PingReq_Handler,
+		},
+		{
+			MethodName: "Sync",
+			Handler:    _GossipService_Sync_Handler,
+		},
+		{
+			MethodName: "GossipMembers",
+			Handler:    _GossipService_GossipMembers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cash_proto/cash.proto",
+}
+
+// ReplicationServiceClient is the client API for ReplicationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ReplicationServiceClient interface {
+	Replicate(ctx context.Context, in *ReplicaSync, opts ...grpc.CallOption) (ReplicationService_ReplicateClient, error)
+	ReplicationInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReplicationState, error)
+	ReplicaOf(ctx context.Context, in *ReplicaOfRequest, opts ...grpc.CallOption) (*ReplicationState, error)
+}
+
+type replicationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReplicationServiceClient(cc grpc.ClientConnInterface) ReplicationServiceClient {
+	return &replicationServiceClient{cc}
+}
+
+func (c *replicationServiceClient) Replicate(ctx context.Context, in *ReplicaSync, opts ...grpc.CallOption) (ReplicationService_ReplicateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ReplicationService_ServiceDesc.Streams[0], "/ReplicationService/Replicate", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &replicationServiceReplicateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ReplicationService_ReplicateClient interface {
+	Recv() (*ReplicationEvent, error)
+	grpc.ClientStream
+}
+
+type replicationServiceReplicateClient struct {
+	grpc.ClientStream
+}
+
+func (x *replicationServiceReplicateClient) Recv() (*ReplicationEvent, error) {
+	m := new(ReplicationEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *replicationServiceClient) ReplicationInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ReplicationState, error) {
+	out := new(ReplicationState)
+	err := c.cc.Invoke(ctx, "/ReplicationService/ReplicationInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *replicationServiceClient) ReplicaOf(ctx context.Context, in *ReplicaOfRequest, opts ...grpc.CallOption) (*ReplicationState, error) {
+	out := new(ReplicationState)
+	err := c.cc.Invoke(ctx, "/ReplicationService/ReplicaOf", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReplicationServiceServer is the server API for ReplicationService service.
+// All implementations must embed UnimplementedReplicationServiceServer
+// for forward compatibility
+type ReplicationServiceServer interface {
+	Replicate(*ReplicaSync, ReplicationService_ReplicateServer) error
+	ReplicationInfo(context.Context, *emptypb.Empty) (*ReplicationState, error)
+	ReplicaOf(context.Context, *ReplicaOfRequest) (*ReplicationState, error)
+	mustEmbedUnimplementedReplicationServiceServer()
+}
+
+// UnimplementedReplicationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedReplicationServiceServer struct {
+}
+
+func (UnimplementedReplicationServiceServer) Replicate(*ReplicaSync, ReplicationService_ReplicateServer) error {
+	return status.Errorf(codes.Unimplemented, "method Replicate not implemented")
+}
+func (UnimplementedReplicationServiceServer) ReplicationInfo(context.Context, *emptypb.Empty) (*ReplicationState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicationInfo not implemented")
+}
+func (UnimplementedReplicationServiceServer) ReplicaOf(context.Context, *ReplicaOfRequest) (*ReplicationState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplicaOf not implemented")
+}
+func (UnimplementedReplicationServiceServer) mustEmbedUnimplementedReplicationServiceServer() {}
+
+// UnsafeReplicationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReplicationServiceServer will
+// result in compilation errors.
+type UnsafeReplicationServiceServer interface {
+	mustEmbedUnimplementedReplicationServiceServer()
+}
+
+func RegisterReplicationServiceServer(s grpc.ServiceRegistrar, srv ReplicationServiceServer) {
+	s.RegisterService(&ReplicationService_ServiceDesc, srv)
+}
+
+func _ReplicationService_Replicate_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReplicaSync)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ReplicationServiceServer).Replicate(m, &replicationServiceReplicateServer{stream})
+}
+
+type ReplicationService_ReplicateServer interface {
+	Send(*ReplicationEvent) error
+	grpc.ServerStream
+}
+
+type replicationServiceReplicateServer struct {
+	grpc.ServerStream
+}
+
+func (x *replicationServiceReplicateServer) Send(m *ReplicationEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ReplicationService_ReplicationInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicationServiceServer).ReplicationInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ReplicationService/ReplicationInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicationServiceServer).ReplicationInfo(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReplicationService_ReplicaOf_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplicaOfRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReplicationServiceServer).ReplicaOf(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ReplicationService/ReplicaOf",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReplicationServiceServer).ReplicaOf(ctx, req.(*ReplicaOfRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReplicationService_ServiceDesc is the grpc.ServiceDesc for ReplicationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReplicationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ReplicationService",
+	HandlerType: (*ReplicationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReplicationInfo",
+			Handler:    _ReplicationService_ReplicationInfo_Handler,
+		},
+		{
+			MethodName: "ReplicaOf",
+			Handler:    _ReplicationService_ReplicaOf_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Replicate",
+			Handler:       _ReplicationService_Replicate_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "cash_proto/cash.proto",
+}
+
+// SentinelServiceClient is the client API for SentinelService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SentinelServiceClient interface {
+	SentinelVote(ctx context.Context, in *SentinelVoteRequest, opts ...grpc.CallOption) (*SentinelVoteResponse, error)
+	SentinelAnnounce(ctx context.Context, in *SentinelPrimary, opts ...grpc.CallOption) (*SentinelPrimary, error)
+	SentinelGetPrimary(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SentinelPrimary, error)
+}
+
+type sentinelServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSentinelServiceClient(cc grpc.ClientConnInterface) SentinelServiceClient {
+	return &sentinelServiceClient{cc}
+}
+
+func (c *sentinelServiceClient) SentinelVote(ctx context.Context, in *SentinelVoteRequest, opts ...grpc.CallOption) (*SentinelVoteResponse, error) {
+	out := new(SentinelVoteResponse)
+	err := c.cc.Invoke(ctx, "/SentinelService/SentinelVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentinelServiceClient) SentinelAnnounce(ctx context.Context, in *SentinelPrimary, opts ...grpc.CallOption) (*SentinelPrimary, error) {
+	out := new(SentinelPrimary)
+	err := c.cc.Invoke(ctx, "/SentinelService/SentinelAnnounce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sentinelServiceClient) SentinelGetPrimary(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SentinelPrimary, error) {
+	out := new(SentinelPrimary)
+	err := c.cc.Invoke(ctx, "/SentinelService/SentinelGetPrimary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SentinelServiceServer is the server API for SentinelService service.
+// All implementations must embed UnimplementedSentinelServiceServer
+// for forward compatibility
+type SentinelServiceServer interface {
+	SentinelVote(context.Context, *SentinelVoteRequest) (*SentinelVoteResponse, error)
+	SentinelAnnounce(context.Context, *SentinelPrimary) (*SentinelPrimary, error)
+	SentinelGetPrimary(context.Context, *emptypb.Empty) (*SentinelPrimary, error)
+	mustEmbedUnimplementedSentinelServiceServer()
+}
+
+// UnimplementedSentinelServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSentinelServiceServer struct {
+}
+
+func (UnimplementedSentinelServiceServer) SentinelVote(context.Context, *SentinelVoteRequest) (*SentinelVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SentinelVote not implemented")
+}
+func (UnimplementedSentinelServiceServer) SentinelAnnounce(context.Context, *SentinelPrimary) (*SentinelPrimary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SentinelAnnounce not implemented")
+}
+func (UnimplementedSentinelServiceServer) SentinelGetPrimary(context.Context, *emptypb.Empty) (*SentinelPrimary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SentinelGetPrimary not implemented")
+}
+func (UnimplementedSentinelServiceServer) mustEmbedUnimplementedSentinelServiceServer() {}
+
+// UnsafeSentinelServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SentinelServiceServer will
+// result in compilation errors.
+type UnsafeSentinelServiceServer interface {
+	mustEmbedUnimplementedSentinelServiceServer()
+}
+
+func RegisterSentinelServiceServer(s grpc.ServiceRegistrar, srv SentinelServiceServer) {
+	s.RegisterService(&SentinelService_ServiceDesc, srv)
+}
+
+func _SentinelService_SentinelVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SentinelVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentinelServiceServer).SentinelVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SentinelService/SentinelVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentinelServiceServer).SentinelVote(ctx, req.(*SentinelVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SentinelService_SentinelAnnounce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SentinelPrimary)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SentinelServiceServer).SentinelAnnounce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SentinelService/SentinelAnnounce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentinelServiceServer).SentinelAnnounce(ctx, req.(*SentinelPrimary))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *cacheServiceClient) LPush(ctx context.Context, in *String, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/CacheService/LPush", in, out, opts...)
-	if err != nil {
+func _SentinelService_SentinelGetPrimary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(SentinelServiceServer).SentinelGetPrimary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/SentinelService/SentinelGetPrimary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SentinelServiceServer).SentinelGetPrimary(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *cacheServiceClient) RPush(ctx context.Context, in *String, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/CacheService/RPush", in, out, opts...)
+// SentinelService_ServiceDesc is the grpc.ServiceDesc for SentinelService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SentinelService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "SentinelService",
+	HandlerType: (*SentinelServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SentinelVote",
+			Handler:    _SentinelService_SentinelVote_Handler,
+		},
+		{
+			MethodName: "SentinelAnnounce",
+			Handler:    _SentinelService_SentinelAnnounce_Handler,
+		},
+		{
+			MethodName: "SentinelGetPrimary",
+			Handler:    _SentinelService_SentinelGetPrimary_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cash_proto/cash.proto",
+}
+
+// DynamoServiceClient is the client API for DynamoService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DynamoServiceClient interface {
+	DynamoFetch(ctx context.Context, in *DynamoKeys, opts ...grpc.CallOption) (*DynamoVersions, error)
+	DynamoStore(ctx context.Context, in *DynamoStoreRequest, opts ...grpc.CallOption) (*Count, error)
+	DynamoStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DynamoState, error)
+	DynamoRangeHashes(ctx context.Context, in *DynamoRangeRequest, opts ...grpc.CallOption) (*RangeHashes, error)
+	DynamoRangeDigests(ctx context.Context, in *DynamoRangeRequest, opts ...grpc.CallOption) (*DynamoVersions, error)
+}
+
+type dynamoServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDynamoServiceClient(cc grpc.ClientConnInterface) DynamoServiceClient {
+	return &dynamoServiceClient{cc}
+}
+
+func (c *dynamoServiceClient) DynamoFetch(ctx context.Context, in *DynamoKeys, opts ...grpc.CallOption) (*DynamoVersions, error) {
+	out := new(DynamoVersions)
+	err := c.cc.Invoke(ctx, "/DynamoService/DynamoFetch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cacheServiceClient) GetList(ctx context.Context, in *Key, opts ...grpc.CallOption) (*List, error) {
-	out := new(List)
-	err := c.cc.Invoke(ctx, "/CacheService/GetList", in, out, opts...)
+func (c *dynamoServiceClient) DynamoStore(ctx context.Context, in *DynamoStoreRequest, opts ...grpc.CallOption) (*Count, error) {
+	out := new(Count)
+	err := c.cc.Invoke(ctx, "/DynamoService/DynamoStore", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cacheServiceClient) HMSet(ctx context.Context, in *HashMapItem, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/CacheService/HMSet", in, out, opts...)
+func (c *dynamoServiceClient) DynamoStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DynamoState, error) {
+	out := new(DynamoState)
+	err := c.cc.Invoke(ctx, "/DynamoService/DynamoStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cacheServiceClient) GetHashMap(ctx context.Context, in *Key, opts ...grpc.CallOption) (*List, error) {
-	out := new(List)
-	err := c.cc.Invoke(ctx, "/CacheService/GetHashMap", in, out, opts...)
+func (c *dynamoServiceClient) DynamoRangeHashes(ctx context.Context, in *DynamoRangeRequest, opts ...grpc.CallOption) (*RangeHashes, error) {
+	out := new(RangeHashes)
+	err := c.cc.Invoke(ctx, "/DynamoService/DynamoRangeHashes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *cacheServiceClient) DeleteAll(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Response, error) {
-	out := new(Response)
-	err := c.cc.Invoke(ctx, "/CacheService/DeleteAll", in, out, opts...)
+func (c *dynamoServiceClient) DynamoRangeDigests(ctx context.Context, in *DynamoRangeRequest, opts ...grpc.CallOption) (*DynamoVersions, error) {
+	out := new(DynamoVersions)
+	err := c.cc.Invoke(ctx, "/DynamoService/DynamoRangeDigests", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// CacheServiceServer is the server API for CacheService service.
-// All implementations must embed UnimplementedCacheServiceServer
+// DynamoServiceServer is the server API for DynamoService service.
+// All implementations must embed UnimplementedDynamoServiceServer
 // for forward compatibility
-type CacheServiceServer interface {
-	Set(context.Context, *String) (*Response, error)
-	Get(context.Context, *Key) (*String, error)
-	DeleteKey(context.Context, *Key) (*Response, error)
-	LPush(context.Context, *String) (*Response, error)
-	RPush(context.Context, *String) (*Response, error)
-	GetList(context.Context, *Key) (*List, error)
-	HMSet(context.Context, *HashMapItem) (*Response, error)
-	GetHashMap(context.Context, *Key) (*List, error)
-	DeleteAll(context.Context, *emptypb.Empty) (*Response, error)
-	mustEmbedUnimplementedCacheServiceServer()
+type DynamoServiceServer interface {
+	DynamoFetch(context.Context, *DynamoKeys) (*DynamoVersions, error)
+	DynamoStore(context.Context, *DynamoStoreRequest) (*Count, error)
+	DynamoStatus(context.Context, *emptypb.Empty) (*DynamoState, error)
+	DynamoRangeHashes(context.Context, *DynamoRangeRequest) (*RangeHashes, error)
+	DynamoRangeDigests(context.Context, *DynamoRangeRequest) (*DynamoVersions, error)
+	mustEmbedUnimplementedDynamoServiceServer()
 }
 
-// UnimplementedCacheServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedCacheServiceServer struct {
+// UnimplementedDynamoServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDynamoServiceServer struct {
 }
 
-func (UnimplementedCacheServiceServer) Set(context.Context, *String) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
-}
-func (UnimplementedCacheServiceServer) Get(context.Context, *Key) (*String, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
-}
-func (UnimplementedCacheServiceServer) DeleteKey(context.Context, *Key) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteKey not implemented")
-}
-func (UnimplementedCacheServiceServer) LPush(context.Context, *String) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LPush not implemented")
-}
-func (UnimplementedCacheServiceServer) RPush(context.Context, *String) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RPush not implemented")
+func (UnimplementedDynamoServiceServer) DynamoFetch(context.Context, *DynamoKeys) (*DynamoVersions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DynamoFetch not implemented")
 }
-func (UnimplementedCacheServiceServer) GetList(context.Context, *Key) (*List, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetList not implemented")
+func (UnimplementedDynamoServiceServer) DynamoStore(context.Context, *DynamoStoreRequest) (*Count, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DynamoStore not implemented")
 }
-func (UnimplementedCacheServiceServer) HMSet(context.Context, *HashMapItem) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HMSet not implemented")
+func (UnimplementedDynamoServiceServer) DynamoStatus(context.Context, *emptypb.Empty) (*DynamoState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DynamoStatus not implemented")
 }
-func (UnimplementedCacheServiceServer) GetHashMap(context.Context, *Key) (*List, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetHashMap not implemented")
+func (UnimplementedDynamoServiceServer) DynamoRangeHashes(context.Context, *DynamoRangeRequest) (*RangeHashes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DynamoRangeHashes not implemented")
 }
-func (UnimplementedCacheServiceServer) DeleteAll(context.Context, *emptypb.Empty) (*Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAll not implemented")
+func (UnimplementedDynamoServiceServer) DynamoRangeDigests(context.Context, *DynamoRangeRequest) (*DynamoVersions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DynamoRangeDigests not implemented")
 }
-func (UnimplementedCacheServiceServer) mustEmbedUnimplementedCacheServiceServer() {}
+func (UnimplementedDynamoServiceServer) mustEmbedUnimplementedDynamoServiceServer() {}
 
-// UnsafeCacheServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CacheServiceServer will
+// UnsafeDynamoServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DynamoServiceServer will
 // result in compilation errors.
-type UnsafeCacheServiceServer interface {
-	mustEmbedUnimplementedCacheServiceServer()
+type UnsafeDynamoServiceServer interface {
+	mustEmbedUnimplementedDynamoServiceServer()
 }
 
-func RegisterCacheServiceServer(s grpc.ServiceRegistrar, srv CacheServiceServer) {
-	s.RegisterService(&CacheService_ServiceDesc, srv)
+func RegisterDynamoServiceServer(s grpc.ServiceRegistrar, srv DynamoServiceServer) {
+	s.RegisterService(&DynamoService_ServiceDesc, srv)
 }
 
-func _CacheService_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(String)
+func _DynamoService_DynamoFetch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DynamoKeys)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CacheServiceServer).Set(ctx, in)
+		return srv.(DynamoServiceServer).DynamoFetch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/CacheService/Set",
+		FullMethod: "/DynamoService/DynamoFetch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CacheServiceServer).Set(ctx, req.(*String))
+		return srv.(DynamoServiceServer).DynamoFetch(ctx, req.(*DynamoKeys))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CacheService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Key)
+func _DynamoService_DynamoStore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DynamoStoreRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CacheServiceServer).Get(ctx, in)
+		return srv.(DynamoServiceServer).DynamoStore(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/CacheService/Get",
+		FullMethod: "/DynamoService/DynamoStore",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CacheServiceServer).Get(ctx, req.(*Key))
+		return srv.(DynamoServiceServer).DynamoStore(ctx, req.(*DynamoStoreRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CacheService_DeleteKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Key)
+func _DynamoService_DynamoStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CacheServiceServer).DeleteKey(ctx, in)
+		return srv.(DynamoServiceServer).DynamoStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/CacheService/DeleteKey",
+		FullMethod: "/DynamoService/DynamoStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CacheServiceServer).DeleteKey(ctx, req.(*Key))
+		return srv.(DynamoServiceServer).DynamoStatus(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CacheService_LPush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(String)
+func _DynamoService_DynamoRangeHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DynamoRangeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CacheServiceServer).LPush(ctx, in)
+		return srv.(DynamoServiceServer).DynamoRangeHashes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/CacheService/LPush",
+		FullMethod: "/DynamoService/DynamoRangeHashes",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CacheServiceServer).LPush(ctx, req.(*String))
+		return srv.(DynamoServiceServer).DynamoRangeHashes(ctx, req.(*DynamoRangeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CacheService_RPush_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(String)
+func _DynamoService_DynamoRangeDigests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DynamoRangeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CacheServiceServer).RPush(ctx, in)
+		return srv.(DynamoServiceServer).DynamoRangeDigests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/CacheService/RPush",
+		FullMethod: "/DynamoService/DynamoRangeDigests",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CacheServiceServer).RPush(ctx, req.(*String))
+		return srv.(DynamoServiceServer).DynamoRangeDigests(ctx, req.(*DynamoRangeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CacheService_GetList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Key)
-	if err := dec(in); err != nil {
+// DynamoService_ServiceDesc is the grpc.ServiceDesc for DynamoService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DynamoService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "DynamoService",
+	HandlerType: (*DynamoServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DynamoFetch",
+			Handler:    _DynamoService_DynamoFetch_Handler,
+		},
+		{
+			MethodName: "DynamoStore",
+			Handler:    _DynamoService_DynamoStore_Handler,
+		},
+		{
+			MethodName: "DynamoStatus",
+			Handler:    _DynamoService_DynamoStatus_Handler,
+		},
+		{
+			MethodName: "DynamoRangeHashes",
+			Handler:    _DynamoService_DynamoRangeHashes_Handler,
+		},
+		{
+			MethodName: "DynamoRangeDigests",
+			Handler:    _DynamoService_DynamoRangeDigests_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cash_proto/cash.proto",
+}
+
+// MultiMasterServiceClient is the client API for MultiMasterService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MultiMasterServiceClient interface {
+	MultiMasterSync(ctx context.Context, in *MultiMasterSyncRequest, opts ...grpc.CallOption) (*MultiMasterSyncResponse, error)
+	MultiMasterStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MultiMasterState, error)
+}
+
+type multiMasterServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMultiMasterServiceClient(cc grpc.ClientConnInterface) MultiMasterServiceClient {
+	return &multiMasterServiceClient{cc}
+}
+
+func (c *multiMasterServiceClient) MultiMasterSync(ctx context.Context, in *MultiMasterSyncRequest, opts ...grpc.CallOption) (*MultiMasterSyncResponse, error) {
+	out := new(MultiMasterSyncResponse)
+	err := c.cc.Invoke(ctx, "/MultiMasterService/MultiMasterSync", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(CacheServiceServer).GetList(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/CacheService/GetList",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CacheServiceServer).GetList(ctx, req.(*Key))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _CacheService_HMSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(HashMapItem)
-	if err := dec(in); err != nil {
+func (c *multiMasterServiceClient) MultiMasterStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MultiMasterState, error) {
+	out := new(MultiMasterState)
+	err := c.cc.Invoke(ctx, "/MultiMasterService/MultiMasterStatus", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(CacheServiceServer).HMSet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/CacheService/HMSet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CacheServiceServer).HMSet(ctx, req.(*HashMapItem))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _CacheService_GetHashMap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Key)
+// MultiMasterServiceServer is the server API for MultiMasterService service.
+// All implementations must embed UnimplementedMultiMasterServiceServer
+// for forward compatibility
+type MultiMasterServiceServer interface {
+	MultiMasterSync(context.Context, *MultiMasterSyncRequest) (*MultiMasterSyncResponse, error)
+	MultiMasterStatus(context.Context, *emptypb.Empty) (*MultiMasterState, error)
+	mustEmbedUnimplementedMultiMasterServiceServer()
+}
+
+// UnimplementedMultiMasterServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMultiMasterServiceServer struct {
+}
+
+func (UnimplementedMultiMasterServiceServer) MultiMasterSync(context.Context, *MultiMasterSyncRequest) (*MultiMasterSyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiMasterSync not implemented")
+}
+func (UnimplementedMultiMasterServiceServer) MultiMasterStatus(context.Context, *emptypb.Empty) (*MultiMasterState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiMasterStatus not implemented")
+}
+func (UnimplementedMultiMasterServiceServer) mustEmbedUnimplementedMultiMasterServiceServer() {}
+
+// UnsafeMultiMasterServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MultiMasterServiceServer will
+// result in compilation errors.
+type UnsafeMultiMasterServiceServer interface {
+	mustEmbedUnimplementedMultiMasterServiceServer()
+}
+
+func RegisterMultiMasterServiceServer(s grpc.ServiceRegistrar, srv MultiMasterServiceServer) {
+	s.RegisterService(&MultiMasterService_ServiceDesc, srv)
+}
+
+func _MultiMasterService_MultiMasterSync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiMasterSyncRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CacheServiceServer).GetHashMap(ctx, in)
+		return srv.(MultiMasterServiceServer).MultiMasterSync(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/CacheService/GetHashMap",
+		FullMethod: "/MultiMasterService/MultiMasterSync",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CacheServiceServer).GetHashMap(ctx, req.(*Key))
+		return srv.(MultiMasterServiceServer).MultiMasterSync(ctx, req.(*MultiMasterSyncRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CacheService_DeleteAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _MultiMasterService_MultiMasterStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CacheServiceServer).DeleteAll(ctx, in)
+		return srv.(MultiMasterServiceServer).MultiMasterStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/CacheService/DeleteAll",
+		FullMethod: "/MultiMasterService/MultiMasterStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CacheServiceServer).DeleteAll(ctx, req.(*emptypb.Empty))
+		return srv.(MultiMasterServiceServer).MultiMasterStatus(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// CacheService_ServiceDesc is the grpc.ServiceDesc for CacheService service.
+// MultiMasterService_ServiceDesc is the grpc.ServiceDesc for MultiMasterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var CacheService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "CacheService",
-	HandlerType: (*CacheServiceServer)(nil),
+var MultiMasterService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "MultiMasterService",
+	HandlerType: (*MultiMasterServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Set",
-			Handler:    _CacheService_Set_Handler,
-		},
-		{
-			MethodName: "Get",
-			Handler:    _CacheService_Get_Handler,
-		},
-		{
-			MethodName: "DeleteKey",
-			Handler:    _CacheService_DeleteKey_Handler,
-		},
-		{
-			MethodName: "LPush",
-			Handler:    _CacheService_LPush_Handler,
-		},
-		{
-			MethodName: "RPush",
-			Handler:    _CacheService_RPush_Handler,
-		},
-		{
-			MethodName: "GetList",
-			Handler:    _CacheService_GetList_Handler,
-		},
-		{
-			MethodName: "HMSet",
-			Handler:    _CacheService_HMSet_Handler,
-		},
-		{
-			MethodName: "GetHashMap",
-			Handler:    _CacheService_GetHashMap_Handler,
+			MethodName: "MultiMasterSync",
+			Handler:    _MultiMasterService_MultiMasterSync_Handler,
 		},
 		{
-			MethodName: "DeleteAll",
-			Handler:    _CacheService_DeleteAll_Handler,
+			MethodName: "MultiMasterStatus",
+			Handler:    _MultiMasterService_MultiMasterStatus_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -0,0 +1,202 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/shanukun/cash/cash_proto"
+)
+
+var (
+	ErrInvalidSlot = errors.New("Invalid slot range")
+	ErrNoNode      = errors.New("Unknown cluster node")
+	ErrStaleEpoch  = errors.New("Cluster configuration is older than the current one")
+)
+
+// Store is the local store, used to check keys and move them during slot
+// migrations.
+type Store interface {
+	Exists(key string) bool
+	ScanKeys(fn func(key string) bool)
+	MigrateKeys(keys []string, send func([]*pb.KeyEntry) error) (int, error)
+	RestoreKeys(entries []*pb.KeyEntry, replace bool) (int, error)
+}
+
+// Cluster holds the assignment of hash slots to nodes as seen by this
+// node. Every change to the configuration increases its epoch. Slots being
+// moved to another node are in migrating and slots being moved here in
+// importing, both mapping to the other node.
+//
+// Requests running here are counted by key in running, so that a slot
+// migration does not move keys a request is about to write. Keys being
+// sent to the target of a migration are in sending.
+type Cluster struct {
+	mu        sync.RWMutex
+	self      string
+	store     Store
+	nodes     map[string]string
+	slots     [NumSlots]string
+	epoch     int64
+	migrating map[int]string
+	importing map[int]string
+	migration *pb.MigrationStatus
+	keysMu    sync.Mutex
+	running   map[string]int
+	sending   map[string]bool
+	pb.UnimplementedClusterServiceServer
+}
+
+// New returns the cluster configuration of the node id reachable at
+// address, owning no slots.
+func New(id, address string, store Store) *Cluster {
+	return &Cluster{
+		self:  id,
+		store: store,
+		nodes: map[string]string{
+			id: address,
+		},
+		migrating: make(map[int]string),
+		importing: make(map[int]string),
+		running:   make(map[string]int),
+		sending:   make(map[string]bool),
+	}
+}
+
+func (c *Cluster) Self() string {
+	return c.self
+}
+
+// Owner returns the id and address of the node owning slot, or empty
+// strings if the slot is not assigned.
+func (c *Cluster) Owner(slot int) (string, string) {
+	c.mu.RLock()
+	id := c.slots[slot]
+	address := c.nodes[id]
+	c.mu.RUnlock()
+	return id, address
+}
+
+// Assign assigns slots start to end inclusive to node id, which must be
+// known. An empty id leaves the slots unassigned.
+func (c *Cluster) Assign(start, end int, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.checkRange(start, end, id); err != nil {
+		return err
+	}
+	for s := start; s <= end; s++ {
+		c.slots[s] = id
+	}
+	c.epoch++
+	return nil
+}
+
+// checkRange must be called with c.mu held.
+func (c *Cluster) checkRange(start, end int, id string) error {
+	if start < 0 || end >= NumSlots || start > end {
+		return ErrInvalidSlot
+	}
+	if _, known := c.nodes[id]; id != "" && !known {
+		return ErrNoNode
+	}
+	return nil
+}
+
+// State returns the configuration, with consecutive slots owned by the
+// same node merged into ranges.
+func (c *Cluster) State() *pb.ClusterState {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	state := &pb.ClusterState{
+		Self:  c.self,
+		Epoch: c.epoch,
+	}
+	for id, address := range c.nodes {
+		state.Nodes = append(state.Nodes, &pb.ClusterNode{
+			Id:      id,
+			Address: address,
+		})
+	}
+	sort.Slice(state.Nodes, func(i, j int) bool {
+		return state.Nodes[i].Id < state.Nodes[j].Id
+	})
+
+	for s := 0; s < NumSlots; {
+		e := s
+		for e+1 < NumSlots && c.slots[e+1] == c.slots[s] {
+			e++
+		}
+		if c.slots[s] != "" {
+			state.Slots = append(state.Slots, &pb.SlotRange{
+				Start: int32(s),
+				End:   int32(e),
+				Node:  c.slots[s],
+			})
+		}
+		s = e + 1
+	}
+	return state
+}
+
+func (c *Cluster) ClusterInfo(ctx context.Context, in *empty.Empty) (*pb.ClusterState, error) {
+	return c.State(), nil
+}
+
+// ClusterSetSlots adds or updates nodes and assigns slot ranges to them.
+// A request with an epoch not newer than the current one is rejected, so
+// that the same configuration can be sent to every node. Without an epoch
+// the current one is increased.
+func (c *Cluster) ClusterSetSlots(ctx context.Context, args *pb.SetSlotsRequest) (*pb.ClusterState, error) {
+	c.mu.Lock()
+	if args.Epoch != 0 && args.Epoch <= c.epoch {
+		c.mu.Unlock()
+		return nil, ErrStaleEpoch
+	}
+
+	nodes := make(map[string]string)
+	for id, address := range c.nodes {
+		nodes[id] = address
+	}
+	for _, n := range args.Nodes {
+		if n.Id == "" || n.Address == "" {
+			c.mu.Unlock()
+			return nil, ErrNoNode
+		}
+		nodes[n.Id] = n.Address
+	}
+	for _, r := range args.Slots {
+		if _, known := nodes[r.Node]; r.Node != "" && !known {
+			c.mu.Unlock()
+			return nil, ErrNoNode
+		}
+		if r.Start < 0 || r.End >= NumSlots || r.Start > r.End {
+			c.mu.Unlock()
+			return nil, ErrInvalidSlot
+		}
+	}
+
+	c.nodes = nodes
+	for _, r := range args.Slots {
+		for s := r.Start; s <= r.End; s++ {
+			c.slots[s] = r.Node
+		}
+	}
+	if args.Epoch != 0 {
+		c.epoch = args.Epoch
+	} else {
+		c.epoch++
+	}
+	c.mu.Unlock()
+
+	return c.State(), nil
+}
+
+func (c *Cluster) ClusterKeySlot(ctx context.Context, args *pb.Key) (*pb.Count, error) {
+	return &pb.Count{
+		Count: int64(KeySlot(args.Key)),
+	}, nil
+}
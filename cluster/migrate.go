@@ -0,0 +1,275 @@
+package cluster
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/shanukun/cash/cash_proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrMigrating    = errors.New("A migration is already running")
+	ErrNotOwner     = errors.New("Slots are not owned by this node")
+	ErrSlotMigrates = errors.New("Slots are being migrated to another node")
+)
+
+const (
+	MigrationRunning = "running"
+	MigrationDone    = "done"
+	MigrationFailed  = "failed"
+
+	migrateBatch   = 100
+	migrateTimeout = 10 * time.Second
+	migrateRetry   = 10 * time.Millisecond
+)
+
+// ClusterMigrate starts moving slots args.Start to args.End, keys included,
+// to node args.Target. Keys are sent in batches; while they move, keys
+// still here are served here and others are redirected to the target with
+// ASK. The slots are assigned to the target once all keys are moved.
+//
+// A failed migration can be resumed by calling ClusterMigrate again.
+func (c *Cluster) ClusterMigrate(ctx context.Context, args *pb.MigrateRequest) (*pb.MigrationStatus, error) {
+	c.mu.Lock()
+	if args.Start < 0 || args.End >= NumSlots || args.Start > args.End {
+		c.mu.Unlock()
+		return nil, ErrInvalidSlot
+	}
+	address, known := c.nodes[args.Target]
+	if !known || args.Target == c.self {
+		c.mu.Unlock()
+		return nil, ErrNoNode
+	}
+	if c.migration != nil && c.migration.State == MigrationRunning {
+		c.mu.Unlock()
+		return nil, ErrMigrating
+	}
+	for s := int(args.Start); s <= int(args.End); s++ {
+		if c.slots[s] != c.self {
+			c.mu.Unlock()
+			return nil, ErrNotOwner
+		}
+		if target, migrating := c.migrating[s]; migrating && target != args.Target {
+			c.mu.Unlock()
+			return nil, ErrSlotMigrates
+		}
+	}
+
+	for s := int(args.Start); s <= int(args.End); s++ {
+		c.migrating[s] = args.Target
+	}
+	c.migration = &pb.MigrationStatus{
+		Start:  args.Start,
+		End:    args.End,
+		Target: args.Target,
+		State:  MigrationRunning,
+	}
+	status := proto.Clone(c.migration).(*pb.MigrationStatus)
+	c.mu.Unlock()
+
+	go c.migrate(status, address)
+	return status, nil
+}
+
+func (c *Cluster) ClusterMigrationStatus(ctx context.Context, in *empty.Empty) (*pb.MigrationStatus, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.migration == nil {
+		return &pb.MigrationStatus{}, nil
+	}
+	return proto.Clone(c.migration).(*pb.MigrationStatus), nil
+}
+
+// progress updates the status of the running migration.
+func (c *Cluster) progress(fn func(m *pb.MigrationStatus)) {
+	c.mu.Lock()
+	fn(c.migration)
+	c.mu.Unlock()
+}
+
+// slotKeys returns the keys of slots start to end.
+func (c *Cluster) slotKeys(start, end int) []string {
+	var keys []string
+	c.store.ScanKeys(func(key string) bool {
+		if s := KeySlot(key); s >= start && s <= end {
+			keys = append(keys, key)
+		}
+		return true
+	})
+	return keys
+}
+
+func (c *Cluster) migrate(m *pb.MigrationStatus, address string) {
+	err := c.moveSlots(m, address)
+	if err != nil {
+		c.progress(func(m *pb.MigrationStatus) {
+			m.State = MigrationFailed
+			m.Error = err.Error()
+		})
+		return
+	}
+
+	c.mu.Lock()
+	for s := int(m.Start); s <= int(m.End); s++ {
+		c.slots[s] = m.Target
+		delete(c.migrating, s)
+	}
+	c.epoch++
+	c.migration.State = MigrationDone
+	c.mu.Unlock()
+}
+
+// claim marks the keys of batch that no request is running for as being
+// sent and returns them. Requests for these keys are refused until unclaim
+// is called, by which time the keys are either gone or to be sent again.
+func (c *Cluster) claim(batch []string) []string {
+	c.keysMu.Lock()
+	defer c.keysMu.Unlock()
+	var keys []string
+	for _, key := range batch {
+		if c.running[key] == 0 {
+			c.sending[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+func (c *Cluster) unclaim(keys []string) {
+	c.keysMu.Lock()
+	for _, key := range keys {
+		delete(c.sending, key)
+	}
+	c.keysMu.Unlock()
+}
+
+// drained reports whether slots start to end hold no keys and no request
+// for them is running. Requests for missing keys of migrating slots are
+// redirected, so no key can be written to the slots here after that.
+func (c *Cluster) drained(start, end int) bool {
+	c.keysMu.Lock()
+	defer c.keysMu.Unlock()
+	for key := range c.running {
+		if s := KeySlot(key); s >= start && s <= end {
+			return false
+		}
+	}
+	return len(c.slotKeys(start, end)) == 0
+}
+
+// moveSlots sends the keys of the slots of m to the target at address
+// until none are left, as keys may have been written here while earlier
+// batches were sent. Keys requests are running for are left for a later
+// scan.
+func (c *Cluster) moveSlots(m *pb.MigrationStatus, address string) error {
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	target := pb.NewClusterServiceClient(conn)
+
+	send := func(entries []*pb.KeyEntry, done bool) error {
+		ctx, cancel := context.WithTimeout(context.Background(), migrateTimeout)
+		defer cancel()
+		_, err := target.ClusterImport(ctx, &pb.ImportRequest{
+			Source:  c.self,
+			Start:   m.Start,
+			End:     m.End,
+			Entries: entries,
+			Done:    done,
+		})
+		return err
+	}
+
+	// Let the target accept redirected requests before any key moves.
+	if err := send(nil, false); err != nil {
+		return err
+	}
+
+	for {
+		keys := c.slotKeys(int(m.Start), int(m.End))
+		if len(keys) == 0 {
+			if c.drained(int(m.Start), int(m.End)) {
+				break
+			}
+			time.Sleep(migrateRetry)
+			continue
+		}
+		c.progress(func(m *pb.MigrationStatus) {
+			m.Remaining = int64(len(keys))
+		})
+
+		total := 0
+		for i := 0; i < len(keys); i += migrateBatch {
+			batch := keys[i:]
+			if len(batch) > migrateBatch {
+				batch = batch[:migrateBatch]
+			}
+			claimed := c.claim(batch)
+			moved, err := c.store.MigrateKeys(claimed, func(entries []*pb.KeyEntry) error {
+				return send(entries, false)
+			})
+			c.unclaim(claimed)
+			if err != nil {
+				return err
+			}
+			total += moved
+			c.progress(func(m *pb.MigrationStatus) {
+				m.Moved += int64(moved)
+				m.Remaining -= int64(len(batch))
+			})
+		}
+		if total == 0 {
+			// Only keys in use are left.
+			time.Sleep(migrateRetry)
+		}
+	}
+	c.progress(func(m *pb.MigrationStatus) {
+		m.Remaining = 0
+	})
+	return send(nil, true)
+}
+
+// ClusterImport restores keys sent by the node migrating slots args.Start
+// to args.End here. Until the last batch, marked done, requests for these
+// slots are only served if they follow an ASK redirect.
+func (c *Cluster) ClusterImport(ctx context.Context, args *pb.ImportRequest) (*pb.Count, error) {
+	c.mu.Lock()
+	if _, known := c.nodes[args.Source]; !known {
+		c.mu.Unlock()
+		return nil, ErrNoNode
+	}
+	if args.Start < 0 || args.End >= NumSlots || args.Start > args.End {
+		c.mu.Unlock()
+		return nil, ErrInvalidSlot
+	}
+	for s := int(args.Start); s <= int(args.End); s++ {
+		c.importing[s] = args.Source
+	}
+	c.mu.Unlock()
+
+	restored, err := c.store.RestoreKeys(args.Entries, true)
+	if err != nil {
+		return nil, err
+	}
+
+	if args.Done {
+		c.mu.Lock()
+		for s := int(args.Start); s <= int(args.End); s++ {
+			c.slots[s] = c.self
+			delete(c.importing, s)
+		}
+		c.epoch++
+		c.mu.Unlock()
+	}
+
+	return &pb.Count{
+		Count: int64(restored),
+	}, nil
+}
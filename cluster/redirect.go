@@ -0,0 +1,256 @@
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// keyFields are the request fields holding cache keys.
+var keyFields = []protoreflect.Name{"key", "keys", "dest", "source", "sources"}
+
+// RequestKeys returns the cache keys a request operates on.
+func RequestKeys(req interface{}) []string {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+	msg := m.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+
+	var keys []string
+	for _, name := range keyFields {
+		fd := fields.ByName(name)
+		if fd == nil || fd.Kind() != protoreflect.StringKind || !msg.Has(fd) {
+			continue
+		}
+		if fd.IsList() {
+			list := msg.Get(fd).List()
+			for i := 0; i < list.Len(); i++ {
+				keys = append(keys, list.Get(i).String())
+			}
+		} else {
+			keys = append(keys, msg.Get(fd).String())
+		}
+	}
+	return keys
+}
+
+// NewResponse returns an empty response of method, such as
+// /CacheService/Get, for forwarding a request to another node.
+func NewResponse(method string) (proto.Message, error) {
+	parts := strings.Split(strings.TrimPrefix(method, "/"), "/")
+	if len(parts) != 2 {
+		return nil, status.Errorf(codes.Unimplemented, "method %s not implemented", method)
+	}
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(parts[0]))
+	if err != nil {
+		return nil, err
+	}
+	sd, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "method %s not implemented", method)
+	}
+	md := sd.Methods().ByName(protoreflect.Name(parts[1]))
+	if md == nil {
+		return nil, status.Errorf(codes.Unimplemented, "method %s not implemented", method)
+	}
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+	if err != nil {
+		return nil, err
+	}
+	return mt.New().Interface(), nil
+}
+
+// RequestSlot returns the slot of the keys of req, or -1 if it has none.
+// Every key must map to the same slot.
+func RequestSlot(req interface{}) (int, error) {
+	slot := -1
+	for _, key := range RequestKeys(req) {
+		s := KeySlot(key)
+		if slot >= 0 && s != slot {
+			return 0, status.Error(codes.InvalidArgument, "CROSSSLOT Keys in request don't hash to the same slot")
+		}
+		slot = s
+	}
+	return slot, nil
+}
+
+// redirectError returns the error telling clients to send requests for
+// slot to node. Besides its message, the error carries a pb.Redirect
+// detail.
+func redirectError(slot int, node, address string, ask bool) error {
+	kind := "MOVED"
+	if ask {
+		kind = "ASK"
+	}
+	st := status.New(codes.FailedPrecondition, fmt.Sprintf("%s %d %s", kind, slot, address))
+	st, err := st.WithDetails(&pb.Redirect{
+		Slot:    int32(slot),
+		Node:    node,
+		Address: address,
+		Ask:     ask,
+	})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return st.Err()
+}
+
+// ParseRedirect returns the redirect carried by err, if any.
+func ParseRedirect(err error) (*pb.Redirect, bool) {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.FailedPrecondition {
+		return nil, false
+	}
+	for _, d := range st.Details() {
+		if r, ok := d.(*pb.Redirect); ok {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+// askingKey is the metadata set by clients following an ASK redirect.
+const askingKey = "cash-asking"
+
+// Asking returns a context for a request following an ASK redirect, which
+// the target of a migration only serves with this metadata.
+func Asking(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, askingKey, "1")
+}
+
+func isAsking(ctx context.Context) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return len(md.Get(askingKey)) > 0
+}
+
+// check returns an error if the keys of a request to method are not
+// served by this node. While a slot migrates, keys still here are served
+// and other keys are redirected to the target with ASK. Keys being sent to
+// the target are not served until they are gone.
+func (c *Cluster) check(ctx context.Context, method string, req interface{}) error {
+	if !strings.HasPrefix(method, "/CacheService/") {
+		return nil
+	}
+	slot, err := RequestSlot(req)
+	if err != nil || slot < 0 {
+		return err
+	}
+
+	c.mu.RLock()
+	id := c.slots[slot]
+	address := c.nodes[id]
+	target, migrating := c.migrating[slot]
+	targetAddress := c.nodes[target]
+	_, importing := c.importing[slot]
+	c.mu.RUnlock()
+
+	switch {
+	case id == c.self && migrating:
+		found := 0
+		keys := RequestKeys(req)
+		if c.isSending(keys) {
+			return status.Errorf(codes.Unavailable, "TRYAGAIN Keys of slot %d are being migrated", slot)
+		}
+		for _, key := range keys {
+			if c.store.Exists(key) {
+				found++
+			}
+		}
+		switch found {
+		case len(keys):
+			return nil
+		case 0:
+			return redirectError(slot, target, targetAddress, true)
+		}
+		return status.Errorf(codes.Unavailable, "TRYAGAIN Keys of slot %d are being migrated", slot)
+	case id == c.self:
+		return nil
+	case importing && isAsking(ctx):
+		return nil
+	case id == "":
+		return status.Errorf(codes.Unavailable, "CLUSTERDOWN Hash slot %d is not served", slot)
+	}
+	return redirectError(slot, id, address, false)
+}
+
+// isSending reports whether any of keys is being sent to the target of a
+// migration.
+func (c *Cluster) isSending(keys []string) bool {
+	c.keysMu.Lock()
+	defer c.keysMu.Unlock()
+	for _, key := range keys {
+		if c.sending[key] {
+			return true
+		}
+	}
+	return false
+}
+
+// enter counts a request for keys as running until leave is called. It is
+// counted before it is checked, so that keys a checked request may write
+// are not moved by a migration meanwhile.
+func (c *Cluster) enter(keys []string) {
+	c.keysMu.Lock()
+	for _, key := range keys {
+		c.running[key]++
+	}
+	c.keysMu.Unlock()
+}
+
+func (c *Cluster) leave(keys []string) {
+	c.keysMu.Lock()
+	for _, key := range keys {
+		if c.running[key]--; c.running[key] == 0 {
+			delete(c.running, key)
+		}
+	}
+	c.keysMu.Unlock()
+}
+
+// UnaryInterceptor redirects requests for keys owned by other nodes.
+func (c *Cluster) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var keys []string
+		if strings.HasPrefix(info.FullMethod, "/CacheService/") {
+			keys = RequestKeys(req)
+		}
+		c.enter(keys)
+		defer c.leave(keys)
+		if err := c.check(ctx, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor redirects streaming requests for keys owned by other
+// nodes once their request is received.
+func (c *Cluster) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &checkedStream{ServerStream: ss, cluster: c, method: info.FullMethod})
+	}
+}
+
+type checkedStream struct {
+	grpc.ServerStream
+	cluster *Cluster
+	method  string
+}
+
+func (s *checkedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.cluster.check(s.Context(), s.method, m)
+}
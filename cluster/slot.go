@@ -0,0 +1,42 @@
+package cluster
+
+import "strings"
+
+// NumSlots is the number of hash slots the keyspace is divided into.
+const NumSlots = 16384
+
+// crc16 computes the CRC16-CCITT (XMODEM) checksum of key.
+func crc16(key string) uint16 {
+	var crc uint16
+	for i := 0; i < len(key); i++ {
+		crc ^= uint16(key[i]) << 8
+		for j := 0; j < 8; j++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// HashTag returns the part of key between the first { and the following },
+// or key itself if there is no such non empty part. Keys sharing a tag,
+// such as {user:1}:name and {user:1}:email, map to the same slot.
+func HashTag(key string) string {
+	start := strings.IndexByte(key, '{')
+	if start < 0 {
+		return key
+	}
+	end := strings.IndexByte(key[start+1:], '}')
+	if end <= 0 {
+		return key
+	}
+	return key[start+1 : start+1+end]
+}
+
+// KeySlot returns the hash slot of key.
+func KeySlot(key string) int {
+	return int(crc16(HashTag(key)) % NumSlots)
+}
@@ -0,0 +1,523 @@
+// Package crdt replicates keys between sites which all accept writes. The
+// value of every key is a conflict-free replicated data type: strings are
+// last-writer-wins registers stamped by hybrid logical clocks, counters
+// are PN-counters, hashes are observed-remove maps, and sets and lists
+// keep the additions they hold by dot, so that a removal only removes
+// what it has seen. Each site sends the state of the keys it changed to
+// the other sites over links; merging states commutes, so sites converge
+// whatever the order of the writes, and after partitions.
+package crdt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
+)
+
+var (
+	ErrWrongKind   = errors.New("Key holds a different type")
+	ErrNotInteger  = errors.New("Value is not an integer")
+	ErrUnknownSite = errors.New("Site is not linked to this one")
+	ErrUnreachable = errors.New("Site is unreachable")
+
+	// errNoop stops an update which changes nothing.
+	errNoop = errors.New("No change")
+)
+
+const (
+	defaultSyncInterval = 100 * time.Millisecond
+	defaultTimeout      = time.Second
+	// maxStates bounds the states of keys sent by request.
+	maxStates = 512
+)
+
+// Store receives the values of keys as they change, encoded by
+// datatypes.Encode.
+type Store interface {
+	ApplyChanges(changes *pb.CacheChanges) error
+}
+
+type Config struct {
+	ID      string
+	Address string
+	// Sites are the sites linked to this one, which may include it.
+	Sites []*pb.MultiMasterSite
+	// SyncInterval is the time between two sends of the keys changed to
+	// each site.
+	SyncInterval time.Duration
+	Timeout      time.Duration
+
+	// Store is nil to keep the values in the node only, as read by Get.
+	Store     Store
+	Transport Transport
+}
+
+type link struct {
+	id      string
+	address string
+	// dirty holds the keys changed since they were last sent.
+	dirty map[string]bool
+	// incarnation is the last incarnation of the site seen, zero until
+	// it is first reached.
+	incarnation int64
+	connected   bool
+	lastSync    time.Time
+}
+
+// Node is a site.
+type Node struct {
+	pb.UnimplementedMultiMasterServiceServer
+
+	cfg         Config
+	incarnation int64
+
+	mu      sync.Mutex
+	clock   *HLC
+	values  map[string]*Value
+	links   map[string]*link
+	merged  int64
+	stopped chan struct{}
+}
+
+// New returns the site cfg.ID, linked to cfg.Sites.
+func New(cfg Config) *Node {
+	if cfg.SyncInterval == 0 {
+		cfg.SyncInterval = defaultSyncInterval
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	if cfg.Transport == nil {
+		cfg.Transport = NewGRPCTransport()
+	}
+
+	n := &Node{
+		cfg:         cfg,
+		incarnation: time.Now().UnixNano(),
+		clock:       NewHLC(cfg.ID),
+		values:      make(map[string]*Value),
+		links:       make(map[string]*link),
+		stopped:     make(chan struct{}),
+	}
+	for _, s := range cfg.Sites {
+		if s.Id == cfg.ID {
+			continue
+		}
+		n.links[s.Id] = &link{
+			id:      s.Id,
+			address: s.Address,
+			dirty:   make(map[string]bool),
+		}
+	}
+	return n
+}
+
+// Start sends the keys changed to the other sites in the background.
+func (n *Node) Start() {
+	go n.run()
+}
+
+func (n *Node) Stop() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	select {
+	case <-n.stopped:
+	default:
+		close(n.stopped)
+	}
+}
+
+func (n *Node) run() {
+	ticker := time.NewTicker(n.cfg.SyncInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-n.stopped:
+			return
+		case <-ticker.C:
+		}
+		n.SyncAll()
+	}
+}
+
+// update runs fn on the state of key as a write of this site, then sends
+// the new value to the store and marks it for the other sites.
+func (n *Node) update(key string, fn func(v *Value, stamp Timestamp, now int64) error) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	v, ok := n.values[key]
+	if !ok {
+		v = &Value{}
+	}
+	if err := fn(v, n.clock.Now(), time.Now().UnixNano()); err == errNoop {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	n.values[key] = v
+	n.changed(key, "")
+	return n.materialize([]string{key})
+}
+
+// changed marks key for the sites other than from. Must be called with
+// n.mu held.
+func (n *Node) changed(key, from string) {
+	for id, l := range n.links {
+		if id != from {
+			l.dirty[key] = true
+		}
+	}
+}
+
+// materialize sends the values of keys to the store. Must be called with
+// n.mu held.
+func (n *Node) materialize(keys []string) error {
+	if n.cfg.Store == nil {
+		return nil
+	}
+	now := time.Now().UnixNano()
+	changes := &pb.CacheChanges{}
+	for _, key := range keys {
+		value := n.values[key].Materialize(now)
+		if value == nil {
+			changes.Deleted = append(changes.Deleted, key)
+			continue
+		}
+		data, err := dt.Encode(value)
+		if err != nil {
+			return err
+		}
+		e := &pb.KeyEntry{Key: key, Value: data}
+		if exp := dt.Expiration(value); exp > 0 {
+			e.TtlMs = time.Duration(exp - now).Milliseconds()
+			if e.TtlMs <= 0 {
+				changes.Deleted = append(changes.Deleted, key)
+				continue
+			}
+		}
+		changes.Entries = append(changes.Entries, e)
+	}
+	return n.cfg.Store.ApplyChanges(changes)
+}
+
+// Assign sets the string at key, keeping its expiration if keepTTL is set
+// and it holds a string.
+func (n *Node) Assign(key string, data []byte, expiration int64, keepTTL bool) error {
+	return n.update(key, func(v *Value, stamp Timestamp, now int64) error {
+		if kind := v.kind(now); keepTTL && (kind == KindString || kind == KindCounter) {
+			expiration = v.Expiration
+		}
+		v.become(KindString, expiration, now, stamp)
+		v.setExpiration(expiration, stamp)
+		v.String.Assign(data, stamp)
+		return nil
+	})
+}
+
+// Increment adds delta to the counter at key. A string holding an integer
+// becomes a counter starting from it.
+func (n *Node) Increment(key string, delta, expiration int64) error {
+	return n.update(key, func(v *Value, stamp Timestamp, now int64) error {
+		switch v.kind(now) {
+		case KindCounter:
+			v.Stamp = stamp
+			v.Counter.Add(n.cfg.ID, delta)
+			return nil
+		case KindString:
+			start, err := strconv.ParseInt(string(v.String.Data), 10, 64)
+			if err != nil {
+				return ErrNotInteger
+			}
+			v.Kind = KindCounter
+			v.Counter.Reset()
+			// Sites converting the same string concurrently count its value
+			// in the same slot, which merging keeps once.
+			v.Counter.Add(baseSlot(v.String.Stamp), start)
+		case KindNone:
+		default:
+			return ErrWrongKind
+		}
+		v.become(KindCounter, expiration, now, stamp)
+		v.Counter.Add(n.cfg.ID, delta)
+		return nil
+	})
+}
+
+// baseSlot returns the counter slot holding the value of the string
+// assigned at stamp.
+func baseSlot(stamp Timestamp) string {
+	return fmt.Sprintf("base@%d.%d.%s", stamp.Wall, stamp.Logical, stamp.Site)
+}
+
+// Push adds values to the front of the list at key if left is set, and to
+// its back otherwise, in order.
+func (n *Node) Push(key string, left bool, values []string, expiration int64) error {
+	return n.update(key, func(v *Value, stamp Timestamp, now int64) error {
+		if kind := v.kind(now); kind != KindNone && kind != KindList {
+			return ErrWrongKind
+		}
+		v.become(KindList, expiration, now, stamp)
+		for i, value := range values {
+			if i > 0 {
+				stamp = n.clock.Now()
+			}
+			v.List.Push(value, left, n.cfg.ID, stamp)
+		}
+		v.Stamp = stamp
+		return nil
+	})
+}
+
+// SetFields sets fields of the hash at key.
+func (n *Node) SetFields(key string, fields map[string]string, expiration int64) error {
+	return n.update(key, func(v *Value, stamp Timestamp, now int64) error {
+		if kind := v.kind(now); kind != KindNone && kind != KindHash {
+			return ErrWrongKind
+		}
+		v.become(KindHash, expiration, now, stamp)
+		for field, value := range fields {
+			v.Hash.Set(field, []byte(value), n.cfg.ID, stamp)
+		}
+		return nil
+	})
+}
+
+// AddMembers adds members to the set at key.
+func (n *Node) AddMembers(key string, members []string, expiration int64) error {
+	return n.update(key, func(v *Value, stamp Timestamp, now int64) error {
+		if kind := v.kind(now); kind != KindNone && kind != KindSet {
+			return ErrWrongKind
+		}
+		v.become(KindSet, expiration, now, stamp)
+		for _, m := range members {
+			v.Set.Add(m, n.cfg.ID)
+		}
+		return nil
+	})
+}
+
+// RemoveMembers removes members from the set at key, if it holds one.
+func (n *Node) RemoveMembers(key string, members []string) error {
+	return n.update(key, func(v *Value, stamp Timestamp, now int64) error {
+		if v.kind(now) != KindSet {
+			return errNoop
+		}
+		v.Stamp = stamp
+		for _, m := range members {
+			v.Set.Remove(m)
+		}
+		return nil
+	})
+}
+
+// Delete deletes key. Writes to key which it has not seen are kept.
+func (n *Node) Delete(key string) error {
+	return n.update(key, func(v *Value, stamp Timestamp, now int64) error {
+		if v.Stamp == (Timestamp{}) {
+			return errNoop
+		}
+		v.clear()
+		v.Kind, v.Stamp = KindNone, stamp
+		return nil
+	})
+}
+
+// DeleteAll deletes every key.
+func (n *Node) DeleteAll() error {
+	n.mu.Lock()
+	var keys []string
+	for key := range n.values {
+		keys = append(keys, key)
+	}
+	n.mu.Unlock()
+	for _, key := range keys {
+		if err := n.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get returns the value at key, nil if there is none.
+func (n *Node) Get(key string) dt.AnyT {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	v, ok := n.values[key]
+	if !ok {
+		return nil
+	}
+	return v.Materialize(time.Now().UnixNano())
+}
+
+// SyncAll sends the keys changed to every other site.
+func (n *Node) SyncAll() {
+	n.mu.Lock()
+	links := make([]*link, 0, len(n.links))
+	for _, l := range n.links {
+		links = append(links, l)
+	}
+	n.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, l := range links {
+		wg.Add(1)
+		go func(l *link) {
+			defer wg.Done()
+			n.sync(l)
+		}(l)
+	}
+	wg.Wait()
+}
+
+// sync sends the keys changed to the site of l. Keys which could not be
+// sent are sent again next time. A request is sent even if no key
+// changed, to learn whether the site restarted.
+func (n *Node) sync(l *link) error {
+	n.mu.Lock()
+	var keys []string
+	for key := range l.dirty {
+		keys = append(keys, key)
+	}
+	l.dirty = make(map[string]bool)
+	n.mu.Unlock()
+
+	for start := 0; ; start += maxStates {
+		end := start + maxStates
+		if end > len(keys) {
+			end = len(keys)
+		}
+		if err := n.send(l, keys[start:end]); err != nil {
+			n.mu.Lock()
+			for _, key := range keys[start:] {
+				l.dirty[key] = true
+			}
+			l.connected = false
+			n.mu.Unlock()
+			return err
+		}
+		if end == len(keys) {
+			return nil
+		}
+	}
+}
+
+func (n *Node) send(l *link, keys []string) error {
+	req := &pb.MultiMasterSyncRequest{Site: n.cfg.ID, Incarnation: n.incarnation}
+	n.mu.Lock()
+	for _, key := range keys {
+		data, err := encodeValue(n.values[key])
+		if err != nil {
+			n.mu.Unlock()
+			return err
+		}
+		req.States = append(req.States, &pb.CRDTState{Key: key, State: data})
+	}
+	n.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), n.cfg.Timeout)
+	defer cancel()
+	resp, err := n.cfg.Transport.Sync(ctx, l.address, req)
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.seen(l, resp.Incarnation)
+	l.connected = true
+	l.lastSync = time.Now()
+	return nil
+}
+
+// seen records the incarnation of the site of l. A site which restarted
+// lost its keys, which are all sent to it again. Must be called with n.mu
+// held.
+func (n *Node) seen(l *link, incarnation int64) {
+	if l.incarnation != 0 && l.incarnation != incarnation {
+		for key := range n.values {
+			l.dirty[key] = true
+		}
+	}
+	l.incarnation = incarnation
+}
+
+// MultiMasterSync merges the states of keys sent by another site.
+func (n *Node) MultiMasterSync(ctx context.Context, req *pb.MultiMasterSyncRequest) (*pb.MultiMasterSyncResponse, error) {
+	values := make([]*Value, len(req.States))
+	for i, s := range req.States {
+		v, err := decodeValue(s.State)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	l, ok := n.links[req.Site]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSite, req.Site)
+	}
+	n.seen(l, req.Incarnation)
+
+	var changed []string
+	for i, s := range req.States {
+		v := values[i]
+		n.clock.Observe(v.Stamp)
+		cur, ok := n.values[s.Key]
+		if !ok {
+			n.values[s.Key] = v
+		} else if !cur.Merge(v) {
+			continue
+		}
+		n.changed(s.Key, req.Site)
+		changed = append(changed, s.Key)
+	}
+	n.merged += int64(len(changed))
+	if err := n.materialize(changed); err != nil {
+		return nil, err
+	}
+	return &pb.MultiMasterSyncResponse{
+		Site:        n.cfg.ID,
+		Incarnation: n.incarnation,
+		Merged:      int64(len(changed)),
+	}, nil
+}
+
+func (n *Node) State() *pb.MultiMasterState {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	st := &pb.MultiMasterState{
+		Id:     n.cfg.ID,
+		Keys:   int64(len(n.values)),
+		Merged: n.merged,
+	}
+	for _, s := range n.cfg.Sites {
+		l, ok := n.links[s.Id]
+		if !ok {
+			continue
+		}
+		site := &pb.MultiMasterSite{
+			Id:        l.id,
+			Address:   l.address,
+			Connected: l.connected,
+			Pending:   int64(len(l.dirty)),
+		}
+		if !l.lastSync.IsZero() {
+			site.LastSyncMs = l.lastSync.UnixMilli()
+		}
+		st.Sites = append(st.Sites, site)
+	}
+	return st
+}
+
+func (n *Node) MultiMasterStatus(ctx context.Context, _ *empty.Empty) (*pb.MultiMasterState, error) {
+	return n.State(), nil
+}
@@ -0,0 +1,179 @@
+package crdt
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
+)
+
+// newSites returns n sites linked over a LocalTransport. They are not
+// started: tests sync them with converge.
+func newSites(t *testing.T, n int) ([]*Node, *LocalTransport) {
+	t.Helper()
+	transport := NewLocalTransport()
+	var sites []*pb.MultiMasterSite
+	for i := 0; i < n; i++ {
+		id := fmt.Sprint("site", i)
+		sites = append(sites, &pb.MultiMasterSite{Id: id, Address: id})
+	}
+	var nodes []*Node
+	for _, s := range sites {
+		node := New(Config{ID: s.Id, Address: s.Address, Sites: sites, Transport: transport.From(s.Address)})
+		transport.Register(s.Address, node)
+		nodes = append(nodes, node)
+	}
+	return nodes, transport
+}
+
+// converge syncs the sites. Sites are all linked, so a change reaches
+// every site it can reach in one round, and a second round sends back the
+// merges of concurrent changes.
+func converge(nodes []*Node) {
+	for round := 0; round < 2; round++ {
+		for _, n := range nodes {
+			n.SyncAll()
+		}
+	}
+}
+
+// assertConverged fails unless every site holds the same value at each
+// of keys.
+func assertConverged(t *testing.T, nodes []*Node, keys ...string) {
+	t.Helper()
+	for _, key := range keys {
+		want := nodes[0].Get(key)
+		for _, n := range nodes[1:] {
+			if got := n.Get(key); !reflect.DeepEqual(got, want) {
+				t.Errorf("%s holds %+v at %s, %s holds %+v", n.cfg.ID, got, key, nodes[0].cfg.ID, want)
+			}
+		}
+	}
+}
+
+func TestConcurrentAddRemove(t *testing.T) {
+	nodes, _ := newSites(t, 3)
+	a, b, c := nodes[0], nodes[1], nodes[2]
+	if err := a.AddMembers("set", []string{"x", "y", "z"}, 0); err != nil {
+		t.Fatal(err)
+	}
+	converge(nodes)
+
+	// A removal only removes the additions its site has seen, so the
+	// concurrent addition of x survives.
+	a.RemoveMembers("set", []string{"x", "y"})
+	b.AddMembers("set", []string{"x"}, 0)
+	c.RemoveMembers("set", []string{"z"})
+	c.AddMembers("set", []string{"w"}, 0)
+	converge(nodes)
+
+	assertConverged(t, nodes, "set")
+	want := &dt.SetT{Data: map[string]bool{"x": true, "w": true}}
+	if got := a.Get("set"); !reflect.DeepEqual(got, want) {
+		t.Errorf("set holds %+v, want %+v", got, want)
+	}
+}
+
+func TestDeleteConcurrentIncrement(t *testing.T) {
+	nodes, _ := newSites(t, 2)
+	a, b := nodes[0], nodes[1]
+	if err := a.Increment("counter", 5, 0); err != nil {
+		t.Fatal(err)
+	}
+	converge(nodes)
+
+	// The delete does not see the increment of b, which is kept.
+	if err := a.Delete("counter"); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Increment("counter", 2, 0); err != nil {
+		t.Fatal(err)
+	}
+	converge(nodes)
+
+	assertConverged(t, nodes, "counter")
+	got, ok := a.Get("counter").(*dt.StringT)
+	if !ok || string(got.Data) != "2" {
+		t.Errorf("counter holds %+v, want 2", a.Get("counter"))
+	}
+
+	// Once seen, the increment is deleted everywhere.
+	if err := b.Delete("counter"); err != nil {
+		t.Fatal(err)
+	}
+	converge(nodes)
+	assertConverged(t, nodes, "counter")
+	if v := a.Get("counter"); v != nil {
+		t.Errorf("deleted counter holds %+v", v)
+	}
+}
+
+func TestConcurrentStringIncrement(t *testing.T) {
+	nodes, _ := newSites(t, 2)
+	a, b := nodes[0], nodes[1]
+	if err := a.Assign("counter", []byte("10"), 0, false); err != nil {
+		t.Fatal(err)
+	}
+	converge(nodes)
+
+	// Both sites turn the string into a counter, which starts from 10 once.
+	if err := a.Increment("counter", 1, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Increment("counter", 1, 0); err != nil {
+		t.Fatal(err)
+	}
+	converge(nodes)
+
+	assertConverged(t, nodes, "counter")
+	got, ok := a.Get("counter").(*dt.StringT)
+	if !ok || string(got.Data) != "12" {
+		t.Errorf("counter holds %+v, want 12", a.Get("counter"))
+	}
+}
+
+func TestPartitionHeal(t *testing.T) {
+	nodes, transport := newSites(t, 3)
+	a, b, c := nodes[0], nodes[1], nodes[2]
+	keys := []string{"string", "counter", "hash", "list", "set"}
+
+	transport.SetDown(c.cfg.Address, true)
+	a.Assign("string", []byte("a"), 0, false)
+	a.Increment("counter", 1, 0)
+	a.SetFields("hash", map[string]string{"f": "a", "g": "a"}, 0)
+	a.Push("list", false, []string{"a"}, 0)
+	a.AddMembers("set", []string{"a"}, 0)
+	b.Increment("counter", 10, 0)
+	converge(nodes[:2])
+	assertConverged(t, nodes[:2], keys...)
+
+	// The isolated site writes the same keys meanwhile, and last.
+	c.Assign("string", []byte("c"), 0, false)
+	c.Increment("counter", 100, 0)
+	c.SetFields("hash", map[string]string{"f": "c"}, 0)
+	c.Push("list", true, []string{"c"}, 0)
+	c.AddMembers("set", []string{"c"}, 0)
+	converge(nodes)
+	if v := c.Get("counter"); string(v.(*dt.StringT).Data) != "100" {
+		t.Fatalf("partitioned site holds counter %s, want its own 100", v.(*dt.StringT).Data)
+	}
+
+	transport.SetDown(c.cfg.Address, false)
+	converge(nodes)
+	assertConverged(t, nodes, keys...)
+
+	want := map[string]dt.AnyT{
+		"string":  &dt.StringT{Data: []byte("c"), Version: 1},
+		"counter": &dt.StringT{Data: []byte("111")},
+		"hash":    &dt.HashMapT{Data: map[string]string{"f": "c", "g": "a"}},
+		"list":    &dt.ListT{Data: []string{"c", "a"}},
+		"set":     &dt.SetT{Data: map[string]bool{"a": true, "c": true}},
+	}
+	for _, key := range keys {
+		if got := b.Get(key); !reflect.DeepEqual(got, want[key]) {
+			t.Errorf("%s holds %+v, want %+v", key, got, want[key])
+		}
+	}
+}
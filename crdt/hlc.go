@@ -0,0 +1,60 @@
+package crdt
+
+import "time"
+
+// Timestamp is a hybrid logical clock reading: the physical time of the
+// site in nanoseconds, a logical counter ordering the readings of the same
+// nanosecond or of a site whose clock is behind, and the site breaking
+// ties.
+type Timestamp struct {
+	Wall    int64
+	Logical int32
+	Site    string
+}
+
+// Less reports whether t is older than u.
+func (t Timestamp) Less(u Timestamp) bool {
+	if t.Wall != u.Wall {
+		return t.Wall < u.Wall
+	}
+	if t.Logical != u.Logical {
+		return t.Logical < u.Logical
+	}
+	return t.Site < u.Site
+}
+
+// HLC is a hybrid logical clock. Its readings follow physical time while
+// staying greater than every timestamp observed, so that a write is always
+// newer than the writes it has seen, whatever the skew between sites.
+type HLC struct {
+	site string
+	last Timestamp
+	now  func() int64
+}
+
+func NewHLC(site string) *HLC {
+	return &HLC{
+		site: site,
+		now: func() int64 {
+			return time.Now().UnixNano()
+		},
+	}
+}
+
+// Now returns a timestamp greater than every timestamp read or observed.
+func (c *HLC) Now() Timestamp {
+	if wall := c.now(); wall > c.last.Wall {
+		c.last.Wall, c.last.Logical = wall, 0
+	} else {
+		c.last.Logical++
+	}
+	c.last.Site = c.site
+	return c.last
+}
+
+// Observe makes the next readings greater than t.
+func (c *HLC) Observe(t Timestamp) {
+	if t.Wall > c.last.Wall || (t.Wall == c.last.Wall && t.Logical > c.last.Logical) {
+		c.last.Wall, c.last.Logical = t.Wall, t.Logical
+	}
+}
@@ -0,0 +1,78 @@
+package crdt
+
+import "sort"
+
+// List is a list which grows at both ends. Items pushed to the front are
+// ordered newest first and come before the items pushed to the back,
+// ordered oldest first, which is the order of the pushes when they are
+// not concurrent. Items are removed as the members of an ORSet.
+type List struct {
+	Items   map[Dot]Item
+	Context Context
+}
+
+type Item struct {
+	Value string
+	Left  bool
+	Stamp Timestamp
+}
+
+func (l *List) Push(value string, left bool, site string, stamp Timestamp) {
+	if l.Items == nil {
+		l.Items = make(map[Dot]Item)
+	}
+	l.Items[l.Context.next(site)] = Item{Value: value, Left: left, Stamp: stamp}
+}
+
+// Clear removes every item seen.
+func (l *List) Clear() {
+	l.Items = nil
+}
+
+func (l *List) Len() int {
+	return len(l.Items)
+}
+
+func (l *List) Values() []string {
+	items := make([]Item, 0, len(l.Items))
+	for _, it := range l.Items {
+		items = append(items, it)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if a.Left != b.Left {
+			return a.Left
+		}
+		if a.Left {
+			return b.Stamp.Less(a.Stamp)
+		}
+		return a.Stamp.Less(b.Stamp)
+	})
+	values := make([]string, len(items))
+	for i, it := range items {
+		values[i] = it.Value
+	}
+	return values
+}
+
+// Merge merges o into l and reports whether l changed.
+func (l *List) Merge(o *List) bool {
+	changed := false
+	items := make(map[Dot]Item)
+	for d, it := range l.Items {
+		_, inO := o.Items[d]
+		if keep(d, true, inO, l.Context, o.Context) {
+			items[d] = it
+		} else {
+			changed = true
+		}
+	}
+	for d, it := range o.Items {
+		if _, inL := l.Items[d]; !inL && keep(d, false, true, l.Context, o.Context) {
+			items[d] = it
+			changed = true
+		}
+	}
+	l.Items = items
+	return l.Context.merge(o.Context) || changed
+}
@@ -0,0 +1,79 @@
+package crdt
+
+// ORMap is an observed-remove map of registers: fields are added and
+// removed as the members of an ORSet, and the values of a field set
+// concurrently are resolved by last writer wins.
+type ORMap struct {
+	Fields  map[string]*Field
+	Context Context
+}
+
+type Field struct {
+	Dots  map[Dot]bool
+	Value Register
+}
+
+func (m *ORMap) Set(field string, data []byte, site string, stamp Timestamp) {
+	if m.Fields == nil {
+		m.Fields = make(map[string]*Field)
+	}
+	f, ok := m.Fields[field]
+	if !ok {
+		f = &Field{}
+		m.Fields[field] = f
+	}
+	f.Dots = map[Dot]bool{m.Context.next(site): true}
+	f.Value.Assign(data, stamp)
+}
+
+// Clear removes every field seen.
+func (m *ORMap) Clear() {
+	m.Fields = nil
+}
+
+func (m *ORMap) Len() int {
+	return len(m.Fields)
+}
+
+// Data returns the values of the fields.
+func (m *ORMap) Data() map[string]string {
+	data := make(map[string]string, len(m.Fields))
+	for name, f := range m.Fields {
+		data[name] = string(f.Value.Data)
+	}
+	return data
+}
+
+// Merge merges o into m and reports whether m changed.
+func (m *ORMap) Merge(o *ORMap) bool {
+	changed := false
+	fields := make(map[string]*Field)
+	for name, f := range m.Fields {
+		var odots map[Dot]bool
+		of, ok := o.Fields[name]
+		if ok {
+			odots = of.Dots
+		}
+		dots, c := mergeDots(f.Dots, odots, m.Context, o.Context)
+		changed = changed || c
+		if len(dots) == 0 {
+			continue
+		}
+		merged := &Field{Dots: dots, Value: f.Value}
+		if ok && merged.Value.Merge(of.Value) {
+			changed = true
+		}
+		fields[name] = merged
+	}
+	for name, of := range o.Fields {
+		if _, ok := m.Fields[name]; ok {
+			continue
+		}
+		if dots, _ := mergeDots(nil, of.Dots, m.Context, o.Context); len(dots) > 0 {
+			fields[name] = &Field{Dots: dots, Value: of.Value}
+			changed = true
+		}
+	}
+	m.Fields = fields
+	return m.Context.merge(o.Context) || changed
+}
@@ -0,0 +1,89 @@
+package crdt
+
+// Register is a last-writer-wins register: of two concurrent values, the
+// one with the later timestamp wins.
+type Register struct {
+	Data []byte
+	// Version is one more than the version of the value replaced, as the
+	// versions of strings.
+	Version int64
+	Stamp   Timestamp
+}
+
+func (r *Register) Assign(data []byte, stamp Timestamp) {
+	r.Data = data
+	r.Version++
+	r.Stamp = stamp
+}
+
+// Merge keeps the newer of r and o, and reports whether r changed.
+func (r *Register) Merge(o Register) bool {
+	if !r.Stamp.Less(o.Stamp) {
+		return false
+	}
+	*r = o
+	return true
+}
+
+// PNCounter is a counter made of the increments and decrements of each
+// site, which only grow so that merging keeps the largest. A reset
+// records the counts it has seen as a base, so that increments made
+// concurrently with the reset survive it.
+type PNCounter struct {
+	P, N         map[string]int64
+	BaseP, BaseN map[string]int64
+}
+
+func sum(counts map[string]int64) int64 {
+	var total int64
+	for _, c := range counts {
+		total += c
+	}
+	return total
+}
+
+func (c *PNCounter) Value() int64 {
+	return sum(c.P) - sum(c.BaseP) - sum(c.N) + sum(c.BaseN)
+}
+
+func (c *PNCounter) Add(site string, delta int64) {
+	if c.P == nil {
+		c.P, c.N = make(map[string]int64), make(map[string]int64)
+	}
+	if delta >= 0 {
+		c.P[site] += delta
+	} else {
+		c.N[site] -= delta
+	}
+}
+
+// Reset brings the counter back to zero.
+func (c *PNCounter) Reset() {
+	mergeMax(&c.BaseP, c.P)
+	mergeMax(&c.BaseN, c.N)
+}
+
+// Merge keeps the largest counts of c and o, and reports whether c
+// changed.
+func (c *PNCounter) Merge(o *PNCounter) bool {
+	changed := mergeMax(&c.P, o.P)
+	changed = mergeMax(&c.N, o.N) || changed
+	changed = mergeMax(&c.BaseP, o.BaseP) || changed
+	return mergeMax(&c.BaseN, o.BaseN) || changed
+}
+
+// mergeMax raises the counts of dst to those of src, and reports whether
+// any count changed.
+func mergeMax(dst *map[string]int64, src map[string]int64) bool {
+	changed := false
+	for site, c := range src {
+		if *dst == nil {
+			*dst = make(map[string]int64)
+		}
+		if c > (*dst)[site] {
+			(*dst)[site] = c
+			changed = true
+		}
+	}
+	return changed
+}
@@ -0,0 +1,140 @@
+package crdt
+
+import "sort"
+
+// Dot identifies an addition to a collection: the site which made it and
+// its sequence number among the additions of the site.
+type Dot struct {
+	Site string
+	Seq  uint64
+}
+
+// Context records the additions seen by a collection, as the last
+// sequence number of each site. A collection receives the additions of a
+// site in order, since it is always merged whole.
+type Context map[string]uint64
+
+func (c Context) seen(d Dot) bool {
+	return c[d.Site] >= d.Seq
+}
+
+// next returns a new dot of site, recording it as seen.
+func (c *Context) next(site string) Dot {
+	if *c == nil {
+		*c = make(Context)
+	}
+	(*c)[site]++
+	return Dot{Site: site, Seq: (*c)[site]}
+}
+
+func (c *Context) merge(o Context) bool {
+	changed := false
+	for site, seq := range o {
+		if *c == nil {
+			*c = make(Context)
+		}
+		if seq > (*c)[site] {
+			(*c)[site] = seq
+			changed = true
+		}
+	}
+	return changed
+}
+
+// keep reports whether the merge of two collections keeps a dot: it is
+// kept if both hold it, or if the collection which does not hold it has
+// not seen it, in which case it was added concurrently rather than
+// removed.
+func keep(d Dot, inA, inB bool, a, b Context) bool {
+	switch {
+	case inA && inB:
+		return true
+	case inA:
+		return !b.seen(d)
+	default:
+		return !a.seen(d)
+	}
+}
+
+// mergeDots returns the dots of a and b kept by their merge, and whether
+// they differ from the dots of a.
+func mergeDots(a, b map[Dot]bool, ca, cb Context) (map[Dot]bool, bool) {
+	dots := make(map[Dot]bool)
+	changed := false
+	for d := range a {
+		if keep(d, true, b[d], ca, cb) {
+			dots[d] = true
+		} else {
+			changed = true
+		}
+	}
+	for d := range b {
+		if !a[d] && keep(d, false, true, ca, cb) {
+			dots[d] = true
+			changed = true
+		}
+	}
+	return dots, changed
+}
+
+// ORSet is an observed-remove set: a removal only removes the additions
+// it has seen, so that of a concurrent addition and removal of a member,
+// the addition wins.
+type ORSet struct {
+	Members map[string]map[Dot]bool
+	Context Context
+}
+
+func (s *ORSet) Add(member, site string) {
+	if s.Members == nil {
+		s.Members = make(map[string]map[Dot]bool)
+	}
+	s.Members[member] = map[Dot]bool{s.Context.next(site): true}
+}
+
+func (s *ORSet) Remove(member string) {
+	delete(s.Members, member)
+}
+
+// Clear removes every member seen.
+func (s *ORSet) Clear() {
+	s.Members = nil
+}
+
+func (s *ORSet) Len() int {
+	return len(s.Members)
+}
+
+// Elements returns the members in order.
+func (s *ORSet) Elements() []string {
+	members := make([]string, 0, len(s.Members))
+	for m := range s.Members {
+		members = append(members, m)
+	}
+	sort.Strings(members)
+	return members
+}
+
+// Merge merges o into s and reports whether s changed.
+func (s *ORSet) Merge(o *ORSet) bool {
+	changed := false
+	members := make(map[string]map[Dot]bool)
+	for m, dots := range s.Members {
+		merged, c := mergeDots(dots, o.Members[m], s.Context, o.Context)
+		if len(merged) > 0 {
+			members[m] = merged
+		}
+		changed = changed || c
+	}
+	for m, dots := range o.Members {
+		if _, ok := s.Members[m]; ok {
+			continue
+		}
+		if merged, _ := mergeDots(nil, dots, s.Context, o.Context); len(merged) > 0 {
+			members[m] = merged
+			changed = true
+		}
+	}
+	s.Members = members
+	return s.Context.merge(o.Context) || changed
+}
@@ -0,0 +1,101 @@
+package crdt
+
+import (
+	"context"
+	"sync"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Transport sends the states of keys to other sites, given their address.
+type Transport interface {
+	Sync(ctx context.Context, address string, req *pb.MultiMasterSyncRequest) (*pb.MultiMasterSyncResponse, error)
+}
+
+// GRPCTransport sends states to the MultiMasterService of other sites,
+// keeping one connection per address.
+type GRPCTransport struct {
+	mu      sync.Mutex
+	clients map[string]pb.MultiMasterServiceClient
+}
+
+func NewGRPCTransport() *GRPCTransport {
+	return &GRPCTransport{clients: make(map[string]pb.MultiMasterServiceClient)}
+}
+
+func (t *GRPCTransport) client(address string) (pb.MultiMasterServiceClient, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if client, ok := t.clients[address]; ok {
+		return client, nil
+	}
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	client := pb.NewMultiMasterServiceClient(conn)
+	t.clients[address] = client
+	return client, nil
+}
+
+func (t *GRPCTransport) Sync(ctx context.Context, address string, req *pb.MultiMasterSyncRequest) (*pb.MultiMasterSyncResponse, error) {
+	client, err := t.client(address)
+	if err != nil {
+		return nil, err
+	}
+	return client.MultiMasterSync(ctx, req)
+}
+
+// LocalTransport delivers states between sites of the same process, so
+// that sites can run without a network. Each site sends through the
+// Transport returned by From for its own address.
+type LocalTransport struct {
+	mu    sync.RWMutex
+	nodes map[string]*Node
+	down  map[string]bool
+}
+
+func NewLocalTransport() *LocalTransport {
+	return &LocalTransport{
+		nodes: make(map[string]*Node),
+		down:  make(map[string]bool),
+	}
+}
+
+// Register makes node reachable at address.
+func (t *LocalTransport) Register(address string, node *Node) {
+	t.mu.Lock()
+	t.nodes[address] = node
+	t.mu.Unlock()
+}
+
+// SetDown cuts the site at address from the others, or reconnects it, as
+// if its network failed.
+func (t *LocalTransport) SetDown(address string, down bool) {
+	t.mu.Lock()
+	t.down[address] = down
+	t.mu.Unlock()
+}
+
+// From returns the transport of the site at address.
+func (t *LocalTransport) From(address string) Transport {
+	return &localSender{t: t, from: address}
+}
+
+type localSender struct {
+	t    *LocalTransport
+	from string
+}
+
+func (s *localSender) Sync(ctx context.Context, address string, req *pb.MultiMasterSyncRequest) (*pb.MultiMasterSyncResponse, error) {
+	s.t.mu.RLock()
+	node, ok := s.t.nodes[address]
+	down := s.t.down[address] || s.t.down[s.from]
+	s.t.mu.RUnlock()
+	if !ok || down {
+		return nil, ErrUnreachable
+	}
+	return node.MultiMasterSync(ctx, req)
+}
@@ -0,0 +1,150 @@
+package crdt
+
+import (
+	"bytes"
+	"encoding/gob"
+	"strconv"
+
+	dt "github.com/shanukun/cash/datatypes"
+)
+
+// Kind is the type of the value of a key.
+type Kind int8
+
+const (
+	KindNone Kind = iota
+	KindString
+	KindCounter
+	KindHash
+	KindSet
+	KindList
+)
+
+// Value is the state of a key: a CRDT of each kind, and the kind shown,
+// which is set by every write with last writer wins. The CRDTs of other
+// kinds are kept and merged too, so that merges commute however the kinds
+// of concurrent writes interleave.
+type Value struct {
+	Kind  Kind
+	Stamp Timestamp
+	// Expiration is in nanoseconds since the epoch, zero meaning the key
+	// does not expire.
+	Expiration      int64
+	ExpirationStamp Timestamp
+
+	String  Register
+	Counter PNCounter
+	Hash    ORMap
+	Set     ORSet
+	List    List
+}
+
+// kind returns the kind shown at now, KindNone if the key has expired or
+// holds an empty collection.
+func (v *Value) kind(now int64) Kind {
+	if v.Expiration > 0 && now > v.Expiration {
+		return KindNone
+	}
+	switch v.Kind {
+	case KindHash:
+		if v.Hash.Len() == 0 {
+			return KindNone
+		}
+	case KindSet:
+		if v.Set.Len() == 0 {
+			return KindNone
+		}
+	case KindList:
+		if v.List.Len() == 0 {
+			return KindNone
+		}
+	}
+	return v.Kind
+}
+
+// become makes the key hold kind as of stamp. A key which held another
+// kind starts empty, with the given expiration.
+func (v *Value) become(kind Kind, expiration int64, now int64, stamp Timestamp) {
+	if v.kind(now) != kind {
+		v.clear()
+		v.setExpiration(expiration, stamp)
+	}
+	v.Kind, v.Stamp = kind, stamp
+}
+
+// clear removes the items of every collection seen and resets the
+// counter.
+func (v *Value) clear() {
+	v.Counter.Reset()
+	v.Hash.Clear()
+	v.Set.Clear()
+	v.List.Clear()
+}
+
+func (v *Value) setExpiration(expiration int64, stamp Timestamp) {
+	v.Expiration, v.ExpirationStamp = expiration, stamp
+}
+
+// Merge merges o into v and reports whether v changed.
+func (v *Value) Merge(o *Value) bool {
+	changed := false
+	if v.Stamp.Less(o.Stamp) {
+		v.Kind, v.Stamp = o.Kind, o.Stamp
+		changed = true
+	}
+	if v.ExpirationStamp.Less(o.ExpirationStamp) {
+		v.Expiration, v.ExpirationStamp = o.Expiration, o.ExpirationStamp
+		changed = true
+	}
+	changed = v.String.Merge(o.String) || changed
+	changed = v.Counter.Merge(&o.Counter) || changed
+	changed = v.Hash.Merge(&o.Hash) || changed
+	changed = v.Set.Merge(&o.Set) || changed
+	return v.List.Merge(&o.List) || changed
+}
+
+// Materialize returns the value of the store shown at now, nil if the key
+// holds none.
+func (v *Value) Materialize(now int64) dt.AnyT {
+	switch v.kind(now) {
+	case KindString:
+		return &dt.StringT{
+			Data:       append([]byte(nil), v.String.Data...),
+			Expiration: v.Expiration,
+			Version:    v.String.Version,
+		}
+	case KindCounter:
+		return &dt.StringT{
+			Data:       []byte(strconv.FormatInt(v.Counter.Value(), 10)),
+			Expiration: v.Expiration,
+			Version:    v.String.Version,
+		}
+	case KindHash:
+		return &dt.HashMapT{Data: v.Hash.Data(), Expiration: v.Expiration}
+	case KindSet:
+		data := make(map[string]bool, v.Set.Len())
+		for m := range v.Set.Members {
+			data[m] = true
+		}
+		return &dt.SetT{Data: data, Expiration: v.Expiration}
+	case KindList:
+		return &dt.ListT{Data: v.List.Values(), Expiration: v.Expiration}
+	}
+	return nil
+}
+
+func encodeValue(v *Value) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeValue(data []byte) (*Value, error) {
+	v := &Value{}
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
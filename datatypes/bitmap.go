@@ -0,0 +1,119 @@
+package datatypes
+
+import (
+	"math/bits"
+)
+
+// Bits are numbered from the most significant bit of the first byte, so
+// bit 0 is the highest bit of Data[0].
+
+func (s *StringT) grow(n uint64) {
+	if uint64(len(s.Data)) < n {
+		s.Data = append(s.Data, make([]byte, n-uint64(len(s.Data)))...)
+	}
+}
+
+func (s *StringT) GetBit(offset uint64) uint8 {
+	if offset/8 >= uint64(len(s.Data)) {
+		return 0
+	}
+	return (s.Data[offset/8] >> (7 - offset%8)) & 1
+}
+
+// SetBit sets the bit at offset, growing the value if needed, and returns
+// the previous bit.
+func (s *StringT) SetBit(offset uint64, bit uint8) uint8 {
+	s.grow(offset/8 + 1)
+	old := s.GetBit(offset)
+	mask := byte(1) << (7 - offset%8)
+	if bit == 1 {
+		s.Data[offset/8] |= mask
+	} else {
+		s.Data[offset/8] &^= mask
+	}
+	return old
+}
+
+// BitRange converts a range of bytes, or of bits if bitUnit is set, to
+// inclusive bit positions. Negative indexes count from the end. It returns
+// false if the range is empty.
+func (s *StringT) BitRange(start, end int64, bitUnit bool) (uint64, uint64, bool) {
+	length := int64(len(s.Data))
+	if bitUnit {
+		length *= 8
+	}
+	if start < 0 {
+		start += length
+	}
+	if end < 0 {
+		end += length
+	}
+	if start < 0 {
+		start = 0
+	}
+	if end >= length {
+		end = length - 1
+	}
+	if length == 0 || start > end {
+		return 0, 0, false
+	}
+	if bitUnit {
+		return uint64(start), uint64(end), true
+	}
+	return uint64(start) * 8, uint64(end)*8 + 7, true
+}
+
+// BitCount returns the number of set bits between the inclusive bit
+// positions first and last.
+func (s *StringT) BitCount(first, last uint64) int64 {
+	fb, lb := first/8, last/8
+	head := byte(0xff) >> (first % 8)
+	tail := byte(0xff) << (7 - last%8)
+	if fb == lb {
+		return int64(bits.OnesCount8(s.Data[fb] & head & tail))
+	}
+
+	count := bits.OnesCount8(s.Data[fb] & head)
+	for _, b := range s.Data[fb+1 : lb] {
+		count += bits.OnesCount8(b)
+	}
+	count += bits.OnesCount8(s.Data[lb] & tail)
+	return int64(count)
+}
+
+// BitPos returns the position of the first bit equal to bit between the
+// inclusive bit positions first and last, or -1 if there is none.
+func (s *StringT) BitPos(bit uint8, first, last uint64) int64 {
+	skip := byte(0)
+	if bit == 0 {
+		skip = 0xff
+	}
+	for pos := first; pos <= last; {
+		if pos%8 == 0 && pos+7 <= last && s.Data[pos/8] == skip {
+			pos += 8
+			continue
+		}
+		if s.GetBit(pos) == bit {
+			return int64(pos)
+		}
+		pos++
+	}
+	return -1
+}
+
+// GetBits returns width bits starting at offset as an unsigned integer.
+func (s *StringT) GetBits(offset, width uint64) uint64 {
+	var v uint64
+	for i := uint64(0); i < width; i++ {
+		v = v<<1 | uint64(s.GetBit(offset+i))
+	}
+	return v
+}
+
+// SetBits stores the low width bits of v starting at offset.
+func (s *StringT) SetBits(offset, width, v uint64) {
+	s.grow((offset + width + 7) / 8)
+	for i := uint64(0); i < width; i++ {
+		s.SetBit(offset+i, uint8(v>>(width-1-i))&1)
+	}
+}
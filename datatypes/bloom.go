@@ -0,0 +1,138 @@
+package datatypes
+
+import (
+	"math"
+)
+
+// BloomLayer is a single fixed size Bloom filter of a BloomT.
+type BloomLayer struct {
+	Bits      []uint64
+	M         uint64
+	K         uint64
+	Capacity  uint64
+	Count     uint64
+	ErrorRate float64
+}
+
+// BloomT is a scalable Bloom filter. Once a layer holds as many items as
+// it was sized for, a new layer Expansion times larger and with half the
+// error rate is added, keeping the overall error rate below ErrorRate.
+type BloomT struct {
+	Layers     []*BloomLayer
+	ErrorRate  float64
+	Expansion  uint64
+	NonScaling bool
+	Expiration int64
+}
+
+func newBloomLayer(capacity uint64, errorRate float64) *BloomLayer {
+	m := uint64(math.Ceil(-float64(capacity) * math.Log(errorRate) / (math.Ln2 * math.Ln2)))
+	if m < 64 {
+		m = 64
+	}
+	k := uint64(math.Ceil(-math.Log2(errorRate)))
+	if k < 1 {
+		k = 1
+	}
+	return &BloomLayer{
+		Bits:      make([]uint64, (m+63)/64),
+		M:         m,
+		K:         k,
+		Capacity:  capacity,
+		ErrorRate: errorRate,
+	}
+}
+
+func NewBloom(capacity uint64, errorRate float64, expansion uint64, nonScaling bool, expiration int64) *BloomT {
+	return &BloomT{
+		Layers:     []*BloomLayer{newBloomLayer(capacity, errorRate/2)},
+		ErrorRate:  errorRate,
+		Expansion:  expansion,
+		NonScaling: nonScaling,
+		Expiration: expiration,
+	}
+}
+
+// filterHashes returns two independent hashes of item, from which the k
+// bit positions are derived by double hashing.
+func filterHashes(item string) (uint64, uint64) {
+	h1 := Hash64(item)
+	h2 := h1*0x9e3779b97f4a7c15 + 0x632be59bd9b4e019
+	h2 ^= h2 >> 32
+	return h1, h2 | 1
+}
+
+func (l *BloomLayer) has(h1, h2 uint64) bool {
+	for i := uint64(0); i < l.K; i++ {
+		bit := (h1 + i*h2) % l.M
+		if l.Bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (l *BloomLayer) add(h1, h2 uint64) {
+	for i := uint64(0); i < l.K; i++ {
+		bit := (h1 + i*h2) % l.M
+		l.Bits[bit/64] |= 1 << (bit % 64)
+	}
+	l.Count++
+}
+
+func (b *BloomT) Exists(item string) bool {
+	h1, h2 := filterHashes(item)
+	for _, l := range b.Layers {
+		if l.has(h1, h2) {
+			return true
+		}
+	}
+	return false
+}
+
+// Add adds item and reports whether it was not present before. It returns
+// false for a full non-scaling filter as well, with full set.
+func (b *BloomT) Add(item string) (added bool, full bool) {
+	h1, h2 := filterHashes(item)
+	for _, l := range b.Layers {
+		if l.has(h1, h2) {
+			return false, false
+		}
+	}
+
+	l := b.Layers[len(b.Layers)-1]
+	if l.Count >= l.Capacity {
+		if b.NonScaling {
+			return false, true
+		}
+		l = newBloomLayer(l.Capacity*b.Expansion, l.ErrorRate/2)
+		b.Layers = append(b.Layers, l)
+	}
+	l.add(h1, h2)
+	return true, false
+}
+
+func (b *BloomT) Capacity() uint64 {
+	var capacity uint64
+	for _, l := range b.Layers {
+		capacity += l.Capacity
+	}
+	return capacity
+}
+
+func (b *BloomT) Count() uint64 {
+	var count uint64
+	for _, l := range b.Layers {
+		count += l.Count
+	}
+	return count
+}
+
+// Size returns the memory used by the filter bits in bytes.
+func (b *BloomT) Size() uint64 {
+	var size uint64
+	for _, l := range b.Layers {
+		size += uint64(len(l.Bits)) * 8
+	}
+	return size
+}
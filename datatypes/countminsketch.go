@@ -0,0 +1,79 @@
+package datatypes
+
+import (
+	"math"
+)
+
+// CountMinSketchT counts item frequencies in Depth rows of Width counters.
+// Queries never underestimate a count and overestimate it by at most
+// e/Width of the total count with probability 1-exp(-Depth).
+type CountMinSketchT struct {
+	Width      uint64
+	Depth      uint64
+	Counters   []uint64
+	Count      uint64
+	Expiration int64
+}
+
+func NewCountMinSketch(width, depth uint64, expiration int64) *CountMinSketchT {
+	return &CountMinSketchT{
+		Width:      width,
+		Depth:      depth,
+		Counters:   make([]uint64, width*depth),
+		Expiration: expiration,
+	}
+}
+
+// CountMinDims returns the width and depth needed to overestimate counts by
+// at most errorRate of the total count with the given probability.
+func CountMinDims(errorRate, probability float64) (uint64, uint64) {
+	width := uint64(math.Ceil(math.E / errorRate))
+	depth := uint64(math.Ceil(math.Log(1 / probability)))
+	if depth < 1 {
+		depth = 1
+	}
+	return width, depth
+}
+
+func (s *CountMinSketchT) index(row, h1, h2 uint64) uint64 {
+	return row*s.Width + (h1+row*h2)%s.Width
+}
+
+// IncrBy increments the count of item by n and returns its new estimate.
+func (s *CountMinSketchT) IncrBy(item string, n uint64) uint64 {
+	h1, h2 := filterHashes(item)
+	min := uint64(math.MaxUint64)
+	for row := uint64(0); row < s.Depth; row++ {
+		i := s.index(row, h1, h2)
+		s.Counters[i] += n
+		if s.Counters[i] < min {
+			min = s.Counters[i]
+		}
+	}
+	s.Count += n
+	return min
+}
+
+func (s *CountMinSketchT) Query(item string) uint64 {
+	h1, h2 := filterHashes(item)
+	min := uint64(math.MaxUint64)
+	for row := uint64(0); row < s.Depth; row++ {
+		if c := s.Counters[s.index(row, h1, h2)]; c < min {
+			min = c
+		}
+	}
+	return min
+}
+
+// Merge adds the counters of other multiplied by weight. Both sketches
+// must have the same dimensions.
+func (s *CountMinSketchT) Merge(other *CountMinSketchT, weight uint64) bool {
+	if s.Width != other.Width || s.Depth != other.Depth {
+		return false
+	}
+	for i, c := range other.Counters {
+		s.Counters[i] += c * weight
+	}
+	s.Count += other.Count * weight
+	return true
+}
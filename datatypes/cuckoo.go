@@ -0,0 +1,136 @@
+package datatypes
+
+import (
+	"math/rand"
+)
+
+const (
+	cuckooBucketSize = 4
+	cuckooMaxKicks   = 500
+)
+
+// CuckooT is a cuckoo filter with buckets of four 16 bit fingerprints.
+// Unlike a Bloom filter it supports deleting items that were added.
+type CuckooT struct {
+	Buckets    []uint16
+	NumBuckets uint64
+	Count      uint64
+	Expiration int64
+}
+
+func NewCuckoo(capacity uint64, expiration int64) *CuckooT {
+	numBuckets := uint64(1)
+	for numBuckets*cuckooBucketSize*95/100 < capacity {
+		numBuckets <<= 1
+	}
+	return &CuckooT{
+		Buckets:    make([]uint16, numBuckets*cuckooBucketSize),
+		NumBuckets: numBuckets,
+		Expiration: expiration,
+	}
+}
+
+func (c *CuckooT) fingerprint(item string) (uint16, uint64, uint64) {
+	h := Hash64(item)
+	fp := uint16(h >> 48)
+	if fp == 0 {
+		fp = 1
+	}
+	i1 := h & (c.NumBuckets - 1)
+	return fp, i1, c.altIndex(i1, fp)
+}
+
+func (c *CuckooT) altIndex(i uint64, fp uint16) uint64 {
+	return (i ^ (uint64(fp) * 0x5bd1e995)) & (c.NumBuckets - 1)
+}
+
+func (c *CuckooT) bucket(i uint64) []uint16 {
+	return c.Buckets[i*cuckooBucketSize : (i+1)*cuckooBucketSize]
+}
+
+func (c *CuckooT) insert(i uint64, fp uint16) bool {
+	b := c.bucket(i)
+	for j := range b {
+		if b[j] == 0 {
+			b[j] = fp
+			return true
+		}
+	}
+	return false
+}
+
+func (c *CuckooT) Exists(item string) bool {
+	fp, i1, i2 := c.fingerprint(item)
+	for _, i := range []uint64{i1, i2} {
+		for _, f := range c.bucket(i) {
+			if f == fp {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Add adds item, relocating existing fingerprints if both of its buckets
+// are full. It returns false if the filter is full.
+func (c *CuckooT) Add(item string) bool {
+	fp, i1, i2 := c.fingerprint(item)
+	if c.insert(i1, fp) || c.insert(i2, fp) {
+		c.Count++
+		return true
+	}
+
+	// Kick fingerprints around, undoing the moves if no free slot is found
+	// so that a failed insert leaves the filter unchanged.
+	type move struct {
+		i uint64
+		j int
+	}
+	var moves []move
+	i := i1
+	if rand.Intn(2) == 1 {
+		i = i2
+	}
+	for n := 0; n < cuckooMaxKicks; n++ {
+		j := rand.Intn(cuckooBucketSize)
+		b := c.bucket(i)
+		fp, b[j] = b[j], fp
+		moves = append(moves, move{i, j})
+
+		i = c.altIndex(i, fp)
+		if c.insert(i, fp) {
+			c.Count++
+			return true
+		}
+	}
+	for n := len(moves) - 1; n >= 0; n-- {
+		b := c.bucket(moves[n].i)
+		fp, b[moves[n].j] = b[moves[n].j], fp
+	}
+	return false
+}
+
+// Delete removes one occurrence of item and reports whether it was found.
+func (c *CuckooT) Delete(item string) bool {
+	fp, i1, i2 := c.fingerprint(item)
+	for _, i := range []uint64{i1, i2} {
+		b := c.bucket(i)
+		for j := range b {
+			if b[j] == fp {
+				b[j] = 0
+				c.Count--
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (c *CuckooT) Capacity() uint64 {
+	return c.NumBuckets * cuckooBucketSize
+}
+
+// Size returns the memory used by the buckets in bytes.
+func (c *CuckooT) Size() uint64 {
+	return uint64(len(c.Buckets)) * 2
+}
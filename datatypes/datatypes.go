@@ -3,8 +3,9 @@ package datatypes
 type AnyT interface{}
 
 type StringT struct {
-	Data       string
+	Data       []byte
 	Expiration int64
+	Version    int64
 }
 
 type ListT struct {
@@ -16,3 +17,14 @@ type HashMapT struct {
 	Data       map[string]string
 	Expiration int64
 }
+
+type SetT struct {
+	Data       map[string]bool
+	Expiration int64
+}
+
+type LockT struct {
+	Owner      string
+	Token      int64
+	Expiration int64
+}
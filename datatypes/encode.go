@@ -0,0 +1,148 @@
+package datatypes
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+)
+
+func init() {
+	gob.Register(&StringT{})
+	gob.Register(&ListT{})
+	gob.Register(&HashMapT{})
+	gob.Register(&SetT{})
+	gob.Register(&LockT{})
+	gob.Register(&StreamT{})
+	gob.Register(&HyperLogLogT{})
+	gob.Register(&BloomT{})
+	gob.Register(&CuckooT{})
+	gob.Register(&CountMinSketchT{})
+	gob.Register(&TopKT{})
+	gob.Register(&GeoT{})
+	gob.Register(&JSONT{})
+	gob.Register(&TimeSeriesT{})
+	gob.Register(&VectorT{})
+}
+
+// envelope lets gob record the concrete type of an encoded value.
+type envelope struct {
+	Value AnyT
+}
+
+// Encode serializes a value of the store, such as a *StringT or a *GeoT,
+// so that Decode can restore it on another node.
+func Encode(v AnyT) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(envelope{Value: v}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func Decode(data []byte) (AnyT, error) {
+	var e envelope
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&e); err != nil {
+		return nil, err
+	}
+	initMaps(reflect.ValueOf(e.Value))
+	return e.Value, nil
+}
+
+// initMaps replaces the nil maps reachable from v with empty ones, as gob
+// does not transmit empty maps and values expect their maps to be
+// writable.
+func initMaps(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			initMaps(v.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				initMaps(v.Field(i))
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			initMaps(v.Index(i))
+		}
+	case reflect.Map:
+		if v.IsNil() && v.CanSet() {
+			v.Set(reflect.MakeMap(v.Type()))
+		}
+		iter := v.MapRange()
+		for iter.Next() {
+			initMaps(iter.Value())
+		}
+	}
+}
+
+// Expiration returns the expiration of a value of the store, in
+// nanoseconds since the epoch, zero meaning it does not expire.
+func Expiration(v AnyT) int64 {
+	f := reflect.ValueOf(v).Elem().FieldByName("Expiration")
+	if !f.IsValid() {
+		return 0
+	}
+	return f.Int()
+}
+
+func SetExpiration(v AnyT, expiration int64) {
+	f := reflect.ValueOf(v).Elem().FieldByName("Expiration")
+	if f.IsValid() {
+		f.SetInt(expiration)
+	}
+}
+
+type geoGob struct {
+	Members    map[string]GeoPoint
+	Expiration int64
+}
+
+// GobEncode encodes the members of g. The index is rebuilt from them when
+// decoding.
+func (g *GeoT) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(geoGob{Members: g.Members, Expiration: g.Expiration})
+	return buf.Bytes(), err
+}
+
+func (g *GeoT) GobDecode(data []byte) error {
+	var gg geoGob
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&gg); err != nil {
+		return err
+	}
+	*g = *NewGeo(gg.Expiration)
+	for member, p := range gg.Members {
+		g.Add(member, p.Lon, p.Lat)
+	}
+	return nil
+}
+
+type jsonGob struct {
+	Document   string
+	Expiration int64
+}
+
+// GobEncode encodes the document of j as JSON text, as gob can not encode
+// the nil values of decoded documents.
+func (j *JSONT) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(jsonGob{Document: EncodeJSON(j.Root), Expiration: j.Expiration})
+	return buf.Bytes(), err
+}
+
+func (j *JSONT) GobDecode(data []byte) error {
+	var jg jsonGob
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&jg); err != nil {
+		return err
+	}
+	root, err := DecodeJSON(jg.Document)
+	if err != nil {
+		return err
+	}
+	j.Root = root
+	j.Expiration = jg.Expiration
+	return nil
+}
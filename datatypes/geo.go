@@ -0,0 +1,210 @@
+package datatypes
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/shanukun/cash/ds"
+)
+
+const (
+	GeoLatMin = -85.05112878
+	GeoLatMax = 85.05112878
+	GeoLonMin = -180.0
+	GeoLonMax = 180.0
+
+	// geoSteps is the number of bits used for each of longitude and
+	// latitude, giving 52 bit geohash scores.
+	geoSteps = 26
+
+	earthRadius = 6372797.560856
+)
+
+type GeoPoint struct {
+	Lon  float64
+	Lat  float64
+	Hash uint64
+}
+
+type GeoMatch struct {
+	Member   string
+	Point    GeoPoint
+	Distance float64
+}
+
+// GeoT stores members with their positions. Members are indexed in an
+// RBTree by their geohash score, so that members close to each other are
+// close in the index and area searches only scan a few score ranges.
+type GeoT struct {
+	Members    map[string]GeoPoint
+	Index      *ds.RBTree
+	Expiration int64
+}
+
+func NewGeo(expiration int64) *GeoT {
+	return &GeoT{
+		Members:    make(map[string]GeoPoint),
+		Index:      ds.InitRBTree(),
+		Expiration: expiration,
+	}
+}
+
+func ValidGeoPoint(lon, lat float64) bool {
+	return lon >= GeoLonMin && lon <= GeoLonMax && lat >= GeoLatMin && lat <= GeoLatMax
+}
+
+// geoEncode interleaves step bits of longitude and latitude, longitude
+// taking the odd positions.
+func geoEncode(lon, lat float64, step uint) uint64 {
+	latOffset := (lat - GeoLatMin) / (GeoLatMax - GeoLatMin)
+	lonOffset := (lon - GeoLonMin) / (GeoLonMax - GeoLonMin)
+	cells := float64(uint64(1) << step)
+
+	latBits := uint64(math.Min(latOffset*cells, cells-1))
+	lonBits := uint64(math.Min(lonOffset*cells, cells-1))
+
+	var hash uint64
+	for i := int(step) - 1; i >= 0; i-- {
+		hash = hash<<2 | (lonBits>>uint(i)&1)<<1 | latBits>>uint(i)&1
+	}
+	return hash
+}
+
+func geoIndexKey(hash uint64, member string) string {
+	return fmt.Sprintf("%013x", hash) + member
+}
+
+// Add sets the position of member and reports whether it was added.
+func (g *GeoT) Add(member string, lon, lat float64) bool {
+	old, exists := g.Members[member]
+	if exists {
+		g.Index.Delete(geoIndexKey(old.Hash, member))
+	}
+
+	p := GeoPoint{Lon: lon, Lat: lat, Hash: geoEncode(lon, lat, geoSteps)}
+	g.Members[member] = p
+	g.Index.Insert(geoIndexKey(p.Hash, member), member)
+	return !exists
+}
+
+func (g *GeoT) Remove(member string) bool {
+	p, exists := g.Members[member]
+	if !exists {
+		return false
+	}
+	g.Index.Delete(geoIndexKey(p.Hash, member))
+	delete(g.Members, member)
+	return true
+}
+
+func toRad(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+// GeoDistance returns the haversine distance between two points in meters.
+func GeoDistance(lon1, lat1, lon2, lat2 float64) float64 {
+	u := math.Sin((toRad(lat2) - toRad(lat1)) / 2)
+	v := math.Sin((toRad(lon2) - toRad(lon1)) / 2)
+	a := u*u + math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*v*v
+	return 2 * earthRadius * math.Asin(math.Sqrt(a))
+}
+
+// searchStep returns the geohash precision whose cells are at least radius
+// meters wide at lat, so that the cell of the center and its neighbours
+// cover the whole search area. Cells get narrower towards the poles, so
+// the width is taken at the edge of the area closest to the pole.
+func searchStep(radius, lat float64) uint {
+	lat = math.Min(math.Abs(lat)+radius/earthRadius*180/math.Pi, GeoLatMax)
+	for step := uint(geoSteps); step > 1; step-- {
+		cells := float64(uint64(1) << step)
+		height := (GeoLatMax - GeoLatMin) / cells * math.Pi / 180 * earthRadius
+		width := (GeoLonMax - GeoLonMin) / cells * math.Pi / 180 * earthRadius * math.Cos(toRad(lat))
+		if height >= radius && width >= radius {
+			return step
+		}
+	}
+	return 1
+}
+
+// scan calls fn for every member in the cells around (lon, lat) that may
+// be within radius meters.
+func (g *GeoT) scan(lon, lat, radius float64, fn func(member string, p GeoPoint)) {
+	step := searchStep(radius, lat)
+	cells := float64(uint64(1) << step)
+	cellLat := (GeoLatMax - GeoLatMin) / cells
+	cellLon := (GeoLonMax - GeoLonMin) / cells
+
+	seen := make(map[uint64]bool)
+	for dLat := -1.0; dLat <= 1; dLat++ {
+		for dLon := -1.0; dLon <= 1; dLon++ {
+			cLat := math.Max(GeoLatMin, math.Min(GeoLatMax, lat+dLat*cellLat))
+			cLon := lon + dLon*cellLon
+			if cLon < GeoLonMin {
+				cLon += 360
+			} else if cLon > GeoLonMax {
+				cLon -= 360
+			}
+
+			cell := geoEncode(cLon, cLat, step)
+			if seen[cell] {
+				continue
+			}
+			seen[cell] = true
+
+			// The end of the last cell is 1<<52, which has no 13 digit
+			// key, so scores are compared as numbers.
+			shift := 2 * (geoSteps - step)
+			max := (cell + 1) << shift
+			g.Index.Ascend(fmt.Sprintf("%013x", cell<<shift), func(key string, value interface{}) bool {
+				if hash, _ := strconv.ParseUint(key[:13], 16, 64); hash >= max {
+					return false
+				}
+				member := value.(string)
+				fn(member, g.Members[member])
+				return true
+			})
+		}
+	}
+}
+
+// SearchRadius returns members within radius meters of (lon, lat).
+func (g *GeoT) SearchRadius(lon, lat, radius float64) []GeoMatch {
+	var matches []GeoMatch
+	g.scan(lon, lat, radius, func(member string, p GeoPoint) {
+		d := GeoDistance(lon, lat, p.Lon, p.Lat)
+		if d <= radius {
+			matches = append(matches, GeoMatch{Member: member, Point: p, Distance: d})
+		}
+	})
+	return matches
+}
+
+// SearchBox returns members within a box of width by height meters
+// centered at (lon, lat).
+func (g *GeoT) SearchBox(lon, lat, width, height float64) []GeoMatch {
+	var matches []GeoMatch
+	radius := math.Sqrt(width*width+height*height) / 2
+	g.scan(lon, lat, radius, func(member string, p GeoPoint) {
+		// Distances along the latitude and the longitude of the center
+		// are compared to the box, following the curvature of the earth.
+		dy := GeoDistance(lon, lat, lon, p.Lat)
+		dx := GeoDistance(lon, p.Lat, p.Lon, p.Lat)
+		if dy <= height/2 && dx <= width/2 {
+			d := GeoDistance(lon, lat, p.Lon, p.Lat)
+			matches = append(matches, GeoMatch{Member: member, Point: p, Distance: d})
+		}
+	})
+	return matches
+}
+
+// SortGeoMatches sorts matches by distance, farthest first if desc is set.
+func SortGeoMatches(matches []GeoMatch, desc bool) {
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Distance != matches[j].Distance {
+			return (matches[i].Distance < matches[j].Distance) != desc
+		}
+		return matches[i].Member < matches[j].Member
+	})
+}
@@ -0,0 +1,35 @@
+package datatypes
+
+import "testing"
+
+func hasMember(matches []GeoMatch, member string) bool {
+	for _, m := range matches {
+		if m.Member == member {
+			return true
+		}
+	}
+	return false
+}
+
+// Searches covering the last cell of the index, whose scores end at the
+// end of the keyspace.
+func TestGeoSearchLastCell(t *testing.T) {
+	g := NewGeo(0)
+	g.Add("moscow", 37.6, 55.75)
+	g.Add("edge", 179.9, 85)
+
+	for _, c := range []struct {
+		member           string
+		lon, lat, radius float64
+	}{
+		{"moscow", 37.6, 55.75, 6000000},
+		{"edge", 179.9, 85, 1000},
+	} {
+		if matches := g.SearchRadius(c.lon, c.lat, c.radius); !hasMember(matches, c.member) {
+			t.Errorf("search of %v m around (%v, %v) found %v, want %s", c.radius, c.lon, c.lat, matches, c.member)
+		}
+		if matches := g.SearchBox(c.lon, c.lat, c.radius, c.radius); !hasMember(matches, c.member) {
+			t.Errorf("box of %v m around (%v, %v) found %v, want %s", c.radius, c.lon, c.lat, matches, c.member)
+		}
+	}
+}
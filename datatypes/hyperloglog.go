@@ -0,0 +1,164 @@
+package datatypes
+
+import (
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"sort"
+)
+
+const (
+	hllP = 14
+	hllM = 1 << hllP
+
+	// hllSparseMax is the number of registers set in the sparse
+	// representation after which the HyperLogLog switches to dense. Sparse
+	// registers take 4 bytes each, so 3000 of them still take less than
+	// the 16 KiB of the dense array.
+	hllSparseMax = 3000
+)
+
+// HyperLogLogT estimates the number of distinct elements added to it with
+// a standard error of 1.04/sqrt(2^14) = 0.81%. While few registers are
+// set, only those are kept, sorted by index and packed as index<<8|rank,
+// and all of them are kept in a 16 KiB array after that.
+type HyperLogLogT struct {
+	Sparse     []uint32
+	Dense      []uint8
+	Expiration int64
+}
+
+func NewHyperLogLog(expiration int64) *HyperLogLogT {
+	return &HyperLogLogT{
+		Expiration: expiration,
+	}
+}
+
+// Hash64 returns a well mixed 64 bit hash of s that is stable across
+// processes, so that sketches built on different nodes can be merged.
+func Hash64(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	x := h.Sum64()
+
+	// splitmix64 finalizer
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// sparseIndex returns the position of register i in h.Sparse, or where it
+// would be inserted.
+func (h *HyperLogLogT) sparseIndex(i uint16) int {
+	return sort.Search(len(h.Sparse), func(j int) bool {
+		return uint16(h.Sparse[j]>>8) >= i
+	})
+}
+
+func (h *HyperLogLogT) register(i uint16) uint8 {
+	if h.Dense != nil {
+		return h.Dense[i]
+	}
+	j := h.sparseIndex(i)
+	if j < len(h.Sparse) && uint16(h.Sparse[j]>>8) == i {
+		return uint8(h.Sparse[j])
+	}
+	return 0
+}
+
+func (h *HyperLogLogT) set(i uint16, rank uint8) bool {
+	if rank <= h.register(i) {
+		return false
+	}
+	if h.Dense != nil {
+		h.Dense[i] = rank
+		return true
+	}
+
+	r := uint32(i)<<8 | uint32(rank)
+	j := h.sparseIndex(i)
+	if j < len(h.Sparse) && uint16(h.Sparse[j]>>8) == i {
+		h.Sparse[j] = r
+		return true
+	}
+	h.Sparse = append(h.Sparse, 0)
+	copy(h.Sparse[j+1:], h.Sparse[j:])
+	h.Sparse[j] = r
+	if len(h.Sparse) > hllSparseMax {
+		h.toDense()
+	}
+	return true
+}
+
+func (h *HyperLogLogT) toDense() {
+	h.Dense = make([]uint8, hllM)
+	for _, r := range h.Sparse {
+		h.Dense[r>>8] = uint8(r)
+	}
+	h.Sparse = nil
+}
+
+func (h *HyperLogLogT) IsSparse() bool {
+	return h.Dense == nil
+}
+
+// Add adds element and reports whether the estimate may have changed.
+func (h *HyperLogLogT) Add(element string) bool {
+	x := Hash64(element)
+	i := uint16(x >> (64 - hllP))
+	w := x<<hllP | 1<<(hllP-1)
+	rank := uint8(bits.LeadingZeros64(w) + 1)
+	return h.set(i, rank)
+}
+
+// Merge sets every register to the maximum of h and other, so that h
+// estimates the union of both.
+func (h *HyperLogLogT) Merge(other *HyperLogLogT) bool {
+	changed := false
+	if other.Dense != nil {
+		for i, rank := range other.Dense {
+			if rank > 0 && h.set(uint16(i), rank) {
+				changed = true
+			}
+		}
+		return changed
+	}
+	for _, r := range other.Sparse {
+		if h.set(uint16(r>>8), uint8(r)) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+func (h *HyperLogLogT) Count() uint64 {
+	sum := 0.0
+	zeros := 0
+	if h.Dense != nil {
+		for _, rank := range h.Dense {
+			sum += 1 / float64(uint64(1)<<rank)
+			if rank == 0 {
+				zeros++
+			}
+		}
+	} else {
+		for _, r := range h.Sparse {
+			sum += 1 / float64(uint64(1)<<uint8(r))
+		}
+		zeros = hllM - len(h.Sparse)
+		sum += float64(zeros)
+	}
+
+	m := float64(hllM)
+	alpha := 0.7213 / (1 + 1.079/m)
+	estimate := alpha * m * m / sum
+
+	// Linear counting is more accurate for small cardinalities.
+	if estimate <= 2.5*m && zeros > 0 {
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
@@ -0,0 +1,186 @@
+package datatypes
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/shanukun/cash/ds"
+)
+
+// IndexFieldType mirrors pb.IndexFieldType.
+type IndexFieldType int
+
+const (
+	IndexTag IndexFieldType = iota
+	IndexNumeric
+)
+
+type IndexField struct {
+	Name string
+	Type IndexFieldType
+}
+
+// HashIndex indexes the fields of the hashes whose key starts with Prefix.
+// Tag fields map each value to the keys holding it, while numeric fields
+// are kept in an RBTree ordered by value to answer range queries.
+type HashIndex struct {
+	Name    string
+	Prefix  string
+	Fields  []IndexField
+	Tags    map[string]map[string]map[string]bool
+	Numeric map[string]*ds.RBTree
+	Docs    map[string]map[string]string
+}
+
+func NewHashIndex(name, prefix string, fields []IndexField) *HashIndex {
+	idx := &HashIndex{
+		Name:   name,
+		Prefix: prefix,
+		Fields: fields,
+	}
+	idx.Clear()
+	return idx
+}
+
+// Clear removes every key from the index.
+func (idx *HashIndex) Clear() {
+	idx.Tags = make(map[string]map[string]map[string]bool)
+	idx.Numeric = make(map[string]*ds.RBTree)
+	idx.Docs = make(map[string]map[string]string)
+	for _, f := range idx.Fields {
+		if f.Type == IndexNumeric {
+			idx.Numeric[f.Name] = ds.InitRBTree()
+		} else {
+			idx.Tags[f.Name] = make(map[string]map[string]bool)
+		}
+	}
+}
+
+func (idx *HashIndex) Field(name string) (IndexField, bool) {
+	for _, f := range idx.Fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return IndexField{}, false
+}
+
+func (idx *HashIndex) Matches(key string) bool {
+	return strings.HasPrefix(key, idx.Prefix)
+}
+
+func (idx *HashIndex) Len() int {
+	return len(idx.Docs)
+}
+
+// numericKey encodes v so that keys sort in the order of their values,
+// followed by key to keep entries unique.
+func numericKey(v float64, key string) string {
+	if v == 0 {
+		// Negative zero sorts with zero.
+		v = 0
+	}
+	bits := math.Float64bits(v)
+	if v >= 0 {
+		bits ^= 1 << 63
+	} else {
+		bits = ^bits
+	}
+	return fmt.Sprintf("%016x", bits) + key
+}
+
+func ParseNumeric(s string) (float64, bool) {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(v) {
+		return 0, false
+	}
+	return v, true
+}
+
+// Update indexes the fields of the hash at key, replacing what was indexed
+// before. A nil data removes key from the index.
+func (idx *HashIndex) Update(key string, data map[string]string) {
+	idx.Remove(key)
+	if data == nil {
+		return
+	}
+
+	doc := make(map[string]string)
+	for _, f := range idx.Fields {
+		value, exists := data[f.Name]
+		if !exists {
+			continue
+		}
+		if f.Type == IndexNumeric {
+			v, ok := ParseNumeric(value)
+			if !ok {
+				continue
+			}
+			idx.Numeric[f.Name].Insert(numericKey(v, key), key)
+		} else {
+			keys, ok := idx.Tags[f.Name][value]
+			if !ok {
+				keys = make(map[string]bool)
+				idx.Tags[f.Name][value] = keys
+			}
+			keys[key] = true
+		}
+		doc[f.Name] = value
+	}
+	idx.Docs[key] = doc
+}
+
+func (idx *HashIndex) Remove(key string) {
+	doc, exists := idx.Docs[key]
+	if !exists {
+		return
+	}
+	for name, value := range doc {
+		if tree, ok := idx.Numeric[name]; ok {
+			v, _ := ParseNumeric(value)
+			tree.Delete(numericKey(v, key))
+			continue
+		}
+		keys := idx.Tags[name][value]
+		delete(keys, key)
+		if len(keys) == 0 {
+			delete(idx.Tags[name], value)
+		}
+	}
+	delete(idx.Docs, key)
+}
+
+// TagKeys returns the keys whose field holds one of values.
+func (idx *HashIndex) TagKeys(field string, values []string) map[string]bool {
+	res := make(map[string]bool)
+	for _, v := range values {
+		for key := range idx.Tags[field][v] {
+			res[key] = true
+		}
+	}
+	return res
+}
+
+// RangeKeys returns the keys whose numeric field is within [min, max].
+// Exclusive bounds leave out values equal to them.
+func (idx *HashIndex) RangeKeys(field string, min, max float64, exclusiveMin, exclusiveMax bool) map[string]bool {
+	res := make(map[string]bool)
+	tree, ok := idx.Numeric[field]
+	if !ok {
+		return res
+	}
+	tree.Ascend(numericKey(min, ""), func(_ string, value interface{}) bool {
+		key := value.(string)
+		v, _ := ParseNumeric(idx.Docs[key][field])
+		if v > max || (exclusiveMax && v == max) {
+			return false
+		}
+		if !exclusiveMin || v > min {
+			res[key] = true
+		}
+		return true
+	})
+	return res
+}
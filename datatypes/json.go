@@ -0,0 +1,237 @@
+package datatypes
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrJSONPath   = errors.New("Invalid JSON path")
+	ErrJSONNoPath = errors.New("JSON path does not exist")
+	ErrJSONType   = errors.New("JSON value has a different type")
+	ErrJSONRange  = errors.New("JSON array index out of range")
+	ErrJSONValue  = errors.New("Invalid JSON value")
+)
+
+// JSONT is a JSON document, decoded into maps, slices, strings, bools,
+// json.Number and nil.
+type JSONT struct {
+	Root       interface{}
+	Expiration int64
+}
+
+// PathElem is a single step of a JSON path: an object member, or an array
+// index if IsIndex is set. Negative indexes count from the end.
+type PathElem struct {
+	Key     string
+	Index   int
+	IsIndex bool
+}
+
+// ParseJSONPath parses paths such as "$.a.b[0]" or "$['a'][-1]". The
+// leading "$" is optional and "$" alone is the root.
+func ParseJSONPath(path string) ([]PathElem, error) {
+	path = strings.TrimPrefix(path, "$")
+	var elems []PathElem
+	for len(path) > 0 {
+		switch path[0] {
+		case '.':
+			path = path[1:]
+			end := strings.IndexAny(path, ".[")
+			if end < 0 {
+				end = len(path)
+			}
+			if end == 0 {
+				return nil, ErrJSONPath
+			}
+			elems = append(elems, PathElem{Key: path[:end]})
+			path = path[end:]
+		case '[':
+			end := strings.IndexByte(path, ']')
+			if end < 0 {
+				return nil, ErrJSONPath
+			}
+			inner := path[1:end]
+			path = path[end+1:]
+			if len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0] {
+				elems = append(elems, PathElem{Key: inner[1 : len(inner)-1]})
+				continue
+			}
+			i, err := strconv.Atoi(inner)
+			if err != nil {
+				return nil, ErrJSONPath
+			}
+			elems = append(elems, PathElem{Index: i, IsIndex: true})
+		default:
+			if len(elems) > 0 {
+				return nil, ErrJSONPath
+			}
+			path = "." + path
+		}
+	}
+	return elems, nil
+}
+
+func DecodeJSON(s string) (interface{}, error) {
+	d := json.NewDecoder(strings.NewReader(s))
+	d.UseNumber()
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, ErrJSONValue
+	}
+	if d.More() {
+		return nil, ErrJSONValue
+	}
+	return v, nil
+}
+
+func EncodeJSON(v interface{}) string {
+	var b bytes.Buffer
+	e := json.NewEncoder(&b)
+	e.SetEscapeHTML(false)
+	e.Encode(v)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+func arrayIndex(i, length int) (int, bool) {
+	if i < 0 {
+		i += length
+	}
+	return i, i >= 0 && i < length
+}
+
+// Get returns the value at path.
+func (j *JSONT) Get(path []PathElem) (interface{}, error) {
+	node := j.Root
+	for _, p := range path {
+		switch n := node.(type) {
+		case map[string]interface{}:
+			v, ok := n[p.Key]
+			if p.IsIndex || !ok {
+				return nil, ErrJSONNoPath
+			}
+			node = v
+		case []interface{}:
+			i, ok := arrayIndex(p.Index, len(n))
+			if !p.IsIndex || !ok {
+				return nil, ErrJSONNoPath
+			}
+			node = n[i]
+		default:
+			return nil, ErrJSONNoPath
+		}
+	}
+	return node, nil
+}
+
+// UpdateFunc computes the new value for a slot from its current value.
+// Returning del removes the slot.
+type UpdateFunc func(old interface{}, exists bool) (value interface{}, del bool, err error)
+
+// Update replaces the value at path with the result of fn. Only the last
+// element of path may be missing, in which case an object member is
+// created.
+func (j *JSONT) Update(path []PathElem, fn UpdateFunc) error {
+	if len(path) == 0 {
+		v, del, err := fn(j.Root, true)
+		if err != nil {
+			return err
+		}
+		if del {
+			j.Root = nil
+		} else {
+			j.Root = v
+		}
+		return nil
+	}
+
+	parent, err := j.Get(path[:len(path)-1])
+	if err != nil {
+		return err
+	}
+
+	p := path[len(path)-1]
+	switch n := parent.(type) {
+	case map[string]interface{}:
+		if p.IsIndex {
+			return ErrJSONNoPath
+		}
+		old, exists := n[p.Key]
+		v, del, err := fn(old, exists)
+		if err != nil {
+			return err
+		}
+		if del {
+			delete(n, p.Key)
+		} else {
+			n[p.Key] = v
+		}
+	case []interface{}:
+		i, ok := arrayIndex(p.Index, len(n))
+		if !p.IsIndex || !ok {
+			return ErrJSONNoPath
+		}
+		v, del, err := fn(n[i], true)
+		if err != nil {
+			return err
+		}
+		if del {
+			// The parent slice shrinks, so it has to be stored again.
+			shrunk := append(n[:i:i], n[i+1:]...)
+			return j.Update(path[:len(path)-1], func(interface{}, bool) (interface{}, bool, error) {
+				return shrunk, false, nil
+			})
+		}
+		n[i] = v
+	default:
+		return ErrJSONNoPath
+	}
+	return nil
+}
+
+// TypeName returns the JSON type of v.
+func TypeName(v interface{}) string {
+	switch n := v.(type) {
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case json.Number:
+		if _, err := n.Int64(); err == nil {
+			return "integer"
+		}
+		return "number"
+	case nil:
+		return "null"
+	}
+	return "unknown"
+}
+
+// AddNumbers adds two JSON numbers, keeping integers as integers.
+func AddNumbers(a json.Number, b string) (json.Number, error) {
+	x, errX := a.Int64()
+	y, errY := strconv.ParseInt(b, 10, 64)
+	if errX == nil && errY == nil {
+		sum := x + y
+		if (y > 0 && sum > x) || (y <= 0 && sum <= x) {
+			return json.Number(strconv.FormatInt(sum, 10)), nil
+		}
+	}
+
+	fx, err := a.Float64()
+	if err != nil {
+		return "", ErrJSONType
+	}
+	fy, err := strconv.ParseFloat(b, 64)
+	if err != nil {
+		return "", ErrJSONValue
+	}
+	return json.Number(strconv.FormatFloat(fx+fy, 'g', -1, 64)), nil
+}
@@ -0,0 +1,413 @@
+package datatypes
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/shanukun/cash/ds"
+)
+
+var ErrSearchSyntax = errors.New("Invalid search query")
+
+const (
+	bm25K1 = 1.2
+	bm25B  = 0.75
+
+	// fieldGap separates the positions of fields so that phrases do not
+	// match across them.
+	fieldGap = 1
+)
+
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true,
+	"be": true, "but": true, "by": true, "for": true, "if": true, "in": true,
+	"into": true, "is": true, "it": true, "no": true, "not": true, "of": true,
+	"on": true, "or": true, "such": true, "that": true, "the": true,
+	"their": true, "then": true, "there": true, "these": true, "they": true,
+	"this": true, "to": true, "was": true, "will": true, "with": true,
+}
+
+type Token struct {
+	Term string
+	Pos  int
+}
+
+// Tokenize splits text into lower case words of letters and digits and
+// stems them. Stop words are dropped but still take a position, so that
+// phrases match the original text.
+func Tokenize(text string, pos int) ([]Token, int) {
+	var tokens []Token
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, w := range words {
+		if !stopWords[w] {
+			tokens = append(tokens, Token{Term: Stem(w), Pos: pos})
+		}
+		pos++
+	}
+	return tokens, pos
+}
+
+type SearchMatch struct {
+	Key   string
+	Score float64
+}
+
+// SearchIndex is an inverted index over the hash fields or string values
+// of the keys starting with Prefix. Postings map each term to the
+// positions of the term in every key holding it, and DocTerms each key to
+// its terms. Terms are also kept in an RBTree to expand prefix queries.
+type SearchIndex struct {
+	Name     string
+	Prefix   string
+	Fields   []string
+	Strings  bool
+	Postings map[string]map[string][]int
+	Terms    *ds.RBTree
+	DocTerms map[string][]string
+	DocLen   map[string]int
+	TotalLen int
+}
+
+func NewSearchIndex(name, prefix string, fields []string, strings bool) *SearchIndex {
+	idx := &SearchIndex{
+		Name:    name,
+		Prefix:  prefix,
+		Fields:  fields,
+		Strings: strings,
+	}
+	idx.Clear()
+	return idx
+}
+
+func (idx *SearchIndex) Clear() {
+	idx.Postings = make(map[string]map[string][]int)
+	idx.Terms = ds.InitRBTree()
+	idx.DocTerms = make(map[string][]string)
+	idx.DocLen = make(map[string]int)
+	idx.TotalLen = 0
+}
+
+func (idx *SearchIndex) Matches(key string) bool {
+	return strings.HasPrefix(key, idx.Prefix)
+}
+
+func (idx *SearchIndex) Len() int {
+	return len(idx.DocLen)
+}
+
+// Update indexes texts as the content of key, replacing what was indexed
+// before. A nil texts removes key from the index.
+func (idx *SearchIndex) Update(key string, texts []string) {
+	idx.Remove(key)
+	if texts == nil {
+		return
+	}
+
+	var tokens []Token
+	pos := 0
+	for _, text := range texts {
+		var t []Token
+		t, pos = Tokenize(text, pos)
+		tokens = append(tokens, t...)
+		pos += fieldGap
+	}
+
+	for _, t := range tokens {
+		docs, exists := idx.Postings[t.Term]
+		if !exists {
+			docs = make(map[string][]int)
+			idx.Postings[t.Term] = docs
+			idx.Terms.Insert(t.Term, nil)
+		}
+		if _, ok := docs[key]; !ok {
+			idx.DocTerms[key] = append(idx.DocTerms[key], t.Term)
+		}
+		docs[key] = append(docs[key], t.Pos)
+	}
+	idx.DocLen[key] = len(tokens)
+	idx.TotalLen += len(tokens)
+}
+
+func (idx *SearchIndex) Remove(key string) {
+	n, exists := idx.DocLen[key]
+	if !exists {
+		return
+	}
+	for _, term := range idx.DocTerms[key] {
+		docs := idx.Postings[term]
+		delete(docs, key)
+		if len(docs) == 0 {
+			delete(idx.Postings, term)
+			idx.Terms.Delete(term)
+		}
+	}
+	delete(idx.DocTerms, key)
+	delete(idx.DocLen, key)
+	idx.TotalLen -= n
+}
+
+// Search returns the keys matching query ranked by BM25 over the query
+// terms, best first.
+//
+// Words must all match unless separated by |. A word prefixed with - must
+// not match, a word followed by * matches any term starting with it and
+// words in double quotes must appear in sequence. Parentheses group
+// expressions. Stop words are ignored.
+func (idx *SearchIndex) Search(query string) ([]SearchMatch, error) {
+	p := &queryParser{idx: idx, input: []rune(query)}
+	docs, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpace()
+	if p.pos < len(p.input) {
+		return nil, ErrSearchSyntax
+	}
+
+	var matches []SearchMatch
+	for key := range docs {
+		matches = append(matches, SearchMatch{Key: key, Score: idx.score(key, p.terms)})
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Score != matches[j].Score {
+			return matches[i].Score > matches[j].Score
+		}
+		return matches[i].Key < matches[j].Key
+	})
+	return matches, nil
+}
+
+func (idx *SearchIndex) score(key string, terms map[string]bool) float64 {
+	n := float64(len(idx.DocLen))
+	avgLen := float64(idx.TotalLen) / n
+	docLen := float64(idx.DocLen[key])
+
+	var score float64
+	for term := range terms {
+		docs := idx.Postings[term]
+		tf := float64(len(docs[key]))
+		if tf == 0 {
+			continue
+		}
+		df := float64(len(docs))
+		idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+		score += idf * tf * (bm25K1 + 1) / (tf + bm25K1*(1-bm25B+bm25B*docLen/avgLen))
+	}
+	return score
+}
+
+// queryParser evaluates a query while parsing it. Terms that count
+// towards the score are collected in terms. A nil docSet stands for an
+// expression made of stop words only, which does not restrict matches.
+type queryParser struct {
+	idx   *SearchIndex
+	input []rune
+	pos   int
+	terms map[string]bool
+}
+
+type docSet map[string]bool
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// skipSpace skips spaces and punctuation that is not part of the syntax.
+func (p *queryParser) skipSpace() {
+	for p.pos < len(p.input) && !isWordRune(p.input[p.pos]) && !strings.ContainsRune(`|-()"*`, p.input[p.pos]) {
+		p.pos++
+	}
+}
+
+func (p *queryParser) peek() rune {
+	p.skipSpace()
+	if p.pos < len(p.input) {
+		return p.input[p.pos]
+	}
+	return 0
+}
+
+func (p *queryParser) parseOr() (docSet, error) {
+	res, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == '|' {
+		p.pos++
+		docs, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		if res == nil {
+			res = docs
+			continue
+		}
+		for key := range docs {
+			res[key] = true
+		}
+	}
+	return res, nil
+}
+
+// parseAnd intersects the following expressions, subtracting negated
+// ones. Only negated expressions match every key that they do not match.
+func (p *queryParser) parseAnd() (docSet, error) {
+	var res docSet
+	var excluded []docSet
+	units := 0
+	for {
+		c := p.peek()
+		if c == 0 || c == '|' || c == ')' {
+			break
+		}
+
+		negate := c == '-'
+		if negate {
+			p.pos++
+		}
+		docs, err := p.parseUnit(!negate)
+		if err != nil {
+			return nil, err
+		}
+		units++
+
+		switch {
+		case docs == nil:
+		case negate:
+			excluded = append(excluded, docs)
+		case res == nil:
+			res = docs
+		default:
+			for key := range res {
+				if !docs[key] {
+					delete(res, key)
+				}
+			}
+		}
+	}
+
+	if units == 0 {
+		return nil, ErrSearchSyntax
+	}
+	if res == nil {
+		if excluded == nil {
+			return nil, nil
+		}
+		res = make(docSet)
+		for key := range p.idx.DocLen {
+			res[key] = true
+		}
+	}
+	for _, docs := range excluded {
+		for key := range docs {
+			delete(res, key)
+		}
+	}
+	return res, nil
+}
+
+func (p *queryParser) parseUnit(scored bool) (docSet, error) {
+	switch p.peek() {
+	case '(':
+		p.pos++
+		docs, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ')' {
+			return nil, ErrSearchSyntax
+		}
+		p.pos++
+		return docs, nil
+	case '"':
+		p.pos++
+		start := p.pos
+		for p.pos < len(p.input) && p.input[p.pos] != '"' {
+			p.pos++
+		}
+		if p.pos == len(p.input) {
+			return nil, ErrSearchSyntax
+		}
+		text := string(p.input[start:p.pos])
+		p.pos++
+		return p.phrase(text, scored), nil
+	}
+
+	start := p.pos
+	for p.pos < len(p.input) && isWordRune(p.input[p.pos]) {
+		p.pos++
+	}
+	if p.pos == start {
+		return nil, ErrSearchSyntax
+	}
+	word := strings.ToLower(string(p.input[start:p.pos]))
+	if p.pos < len(p.input) && p.input[p.pos] == '*' {
+		p.pos++
+		return p.prefix(word, scored), nil
+	}
+	return p.phrase(word, scored), nil
+}
+
+func (p *queryParser) addTerm(term string, scored bool) {
+	if !scored {
+		return
+	}
+	if p.terms == nil {
+		p.terms = make(map[string]bool)
+	}
+	p.terms[term] = true
+}
+
+func (p *queryParser) prefix(prefix string, scored bool) docSet {
+	res := make(docSet)
+	p.idx.Terms.Ascend(prefix, func(term string, _ interface{}) bool {
+		if !strings.HasPrefix(term, prefix) {
+			return false
+		}
+		p.addTerm(term, scored)
+		for key := range p.idx.Postings[term] {
+			res[key] = true
+		}
+		return true
+	})
+	return res
+}
+
+// phrase returns the keys holding the terms of text in sequence.
+func (p *queryParser) phrase(text string, scored bool) docSet {
+	tokens, _ := Tokenize(text, 0)
+	if len(tokens) == 0 {
+		return nil
+	}
+	res := make(docSet)
+	for _, t := range tokens {
+		p.addTerm(t.Term, scored)
+	}
+
+	first := p.idx.Postings[tokens[0].Term]
+	for key, positions := range first {
+		for _, pos := range positions {
+			if p.phraseAt(key, tokens, pos-tokens[0].Pos) {
+				res[key] = true
+				break
+			}
+		}
+	}
+	return res
+}
+
+func (p *queryParser) phraseAt(key string, tokens []Token, offset int) bool {
+	for _, t := range tokens[1:] {
+		positions := p.idx.Postings[t.Term][key]
+		i := sort.SearchInts(positions, offset+t.Pos)
+		if i == len(positions) || positions[i] != offset+t.Pos {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,186 @@
+package datatypes
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type StreamID struct {
+	Ms  uint64
+	Seq uint64
+}
+
+var MaxStreamID = StreamID{Ms: math.MaxUint64, Seq: math.MaxUint64}
+
+func (id StreamID) String() string {
+	return fmt.Sprintf("%d-%d", id.Ms, id.Seq)
+}
+
+func (id StreamID) Less(other StreamID) bool {
+	if id.Ms != other.Ms {
+		return id.Ms < other.Ms
+	}
+	return id.Seq < other.Seq
+}
+
+// ParseStreamID parses an id of the form "ms-seq". If the sequence part is
+// missing, seq is used.
+func ParseStreamID(s string, seq uint64) (StreamID, error) {
+	msPart, seqPart, hasSeq := strings.Cut(s, "-")
+	ms, err := strconv.ParseUint(msPart, 10, 64)
+	if err != nil {
+		return StreamID{}, err
+	}
+	if hasSeq {
+		seq, err = strconv.ParseUint(seqPart, 10, 64)
+		if err != nil {
+			return StreamID{}, err
+		}
+	}
+	return StreamID{Ms: ms, Seq: seq}, nil
+}
+
+type StreamEntry struct {
+	ID     StreamID
+	Fields map[string]string
+}
+
+type PendingEntry struct {
+	Consumer   string
+	Delivered  int64
+	Deliveries int64
+}
+
+type ConsumerGroup struct {
+	LastDelivered StreamID
+	Pending       map[StreamID]*PendingEntry
+	Consumers     map[string]int64
+}
+
+type StreamT struct {
+	Entries    []StreamEntry
+	LastID     StreamID
+	Groups     map[string]*ConsumerGroup
+	Expiration int64
+}
+
+func NewStream(expiration int64) *StreamT {
+	return &StreamT{
+		Groups:     make(map[string]*ConsumerGroup),
+		Expiration: expiration,
+	}
+}
+
+// NextID returns the id for an entry added at nowMs. Ids are always
+// increasing, even if the clock goes backwards.
+func (s *StreamT) NextID(nowMs uint64) StreamID {
+	if nowMs > s.LastID.Ms {
+		return StreamID{Ms: nowMs}
+	}
+	return StreamID{Ms: s.LastID.Ms, Seq: s.LastID.Seq + 1}
+}
+
+// Append adds an entry with id, which must be greater than LastID.
+func (s *StreamT) Append(id StreamID, fields map[string]string) bool {
+	if !s.LastID.Less(id) {
+		return false
+	}
+	s.Entries = append(s.Entries, StreamEntry{ID: id, Fields: fields})
+	s.LastID = id
+	return true
+}
+
+// Trim removes the oldest entries so that at most maxLen are left and
+// returns the number of entries removed.
+func (s *StreamT) Trim(maxLen int) int {
+	if maxLen < 0 || len(s.Entries) <= maxLen {
+		return 0
+	}
+	removed := len(s.Entries) - maxLen
+	s.Entries = append([]StreamEntry(nil), s.Entries[removed:]...)
+	return removed
+}
+
+// search returns the index of the first entry with an id >= id.
+func (s *StreamT) search(id StreamID) int {
+	return sort.Search(len(s.Entries), func(i int) bool {
+		return !s.Entries[i].ID.Less(id)
+	})
+}
+
+func (s *StreamT) Get(id StreamID) (StreamEntry, bool) {
+	i := s.search(id)
+	if i < len(s.Entries) && s.Entries[i].ID == id {
+		return s.Entries[i], true
+	}
+	return StreamEntry{}, false
+}
+
+// Range returns up to count entries with start <= id <= end, newest first
+// if reverse is set. A count <= 0 means no limit.
+func (s *StreamT) Range(start, end StreamID, count int, reverse bool) []StreamEntry {
+	lo := s.search(start)
+	hi := sort.Search(len(s.Entries), func(i int) bool {
+		return end.Less(s.Entries[i].ID)
+	})
+	if lo >= hi {
+		return nil
+	}
+
+	entries := s.Entries[lo:hi]
+	if count <= 0 || count > len(entries) {
+		count = len(entries)
+	}
+
+	res := make([]StreamEntry, count)
+	for i := 0; i < count; i++ {
+		if reverse {
+			res[i] = entries[len(entries)-1-i]
+		} else {
+			res[i] = entries[i]
+		}
+	}
+	return res
+}
+
+// After returns up to count entries with an id greater than id.
+func (s *StreamT) After(id StreamID, count int) []StreamEntry {
+	if id == MaxStreamID {
+		return nil
+	}
+	next := StreamID{Ms: id.Ms, Seq: id.Seq + 1}
+	if id.Seq == math.MaxUint64 {
+		next = StreamID{Ms: id.Ms + 1}
+	}
+	return s.Range(next, MaxStreamID, count, false)
+}
+
+func (s *StreamT) CreateGroup(name string, lastDelivered StreamID) bool {
+	if _, exists := s.Groups[name]; exists {
+		return false
+	}
+	s.Groups[name] = &ConsumerGroup{
+		LastDelivered: lastDelivered,
+		Pending:       make(map[StreamID]*PendingEntry),
+		Consumers:     make(map[string]int64),
+	}
+	return true
+}
+
+// PendingIDs returns the pending ids of group in order, only those of
+// consumer if it is not empty.
+func (g *ConsumerGroup) PendingIDs(consumer string) []StreamID {
+	var ids []StreamID
+	for id, p := range g.Pending {
+		if consumer == "" || p.Consumer == consumer {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i].Less(ids[j])
+	})
+	return ids
+}
@@ -0,0 +1,246 @@
+package datatypes
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+var (
+	ErrDuplicateSample = errors.New("Sample with this timestamp already exists")
+	ErrSampleTooOld    = errors.New("Sample is older than the retention period")
+)
+
+type Aggregation int
+
+const (
+	AggNone Aggregation = iota
+	AggAvg
+	AggMin
+	AggMax
+	AggSum
+	AggCount
+	AggFirst
+	AggLast
+)
+
+type DuplicatePolicy int
+
+const (
+	DupBlock DuplicatePolicy = iota
+	DupFirst
+	DupLast
+	DupMin
+	DupMax
+	DupSum
+)
+
+type Sample struct {
+	Ts  int64
+	Val float64
+}
+
+// Aggregator accumulates the samples of a single bucket.
+type Aggregator struct {
+	Count int64
+	Sum   float64
+	Min   float64
+	Max   float64
+	First float64
+	Last  float64
+}
+
+func (a *Aggregator) Add(v float64) {
+	if a.Count == 0 {
+		a.Min, a.Max, a.First = v, v, v
+	}
+	a.Count++
+	a.Sum += v
+	a.Min = math.Min(a.Min, v)
+	a.Max = math.Max(a.Max, v)
+	a.Last = v
+}
+
+func (a *Aggregator) Value(agg Aggregation) float64 {
+	switch agg {
+	case AggAvg:
+		return a.Sum / float64(a.Count)
+	case AggMin:
+		return a.Min
+	case AggMax:
+		return a.Max
+	case AggSum:
+		return a.Sum
+	case AggCount:
+		return float64(a.Count)
+	case AggFirst:
+		return a.First
+	}
+	return a.Last
+}
+
+// CompactionRule downsamples every sample added to a series into Dest,
+// one sample per Bucket milliseconds. The open bucket is written once a
+// sample for a later bucket arrives.
+type CompactionRule struct {
+	Dest        string
+	Aggregation Aggregation
+	Bucket      int64
+	Start       int64
+	Open        bool
+	Acc         Aggregator
+}
+
+type TimeSeriesT struct {
+	Samples         []Sample
+	Retention       int64
+	Labels          map[string]string
+	DuplicatePolicy DuplicatePolicy
+	Rules           []*CompactionRule
+	Expiration      int64
+}
+
+func NewTimeSeries(retention int64, labels map[string]string, policy DuplicatePolicy, expiration int64) *TimeSeriesT {
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	return &TimeSeriesT{
+		Retention:       retention,
+		Labels:          labels,
+		DuplicatePolicy: policy,
+		Expiration:      expiration,
+	}
+}
+
+func (ts *TimeSeriesT) search(t int64) int {
+	return sort.Search(len(ts.Samples), func(i int) bool {
+		return ts.Samples[i].Ts >= t
+	})
+}
+
+// DeleteRule deletes the compaction rule into dest and reports whether
+// there was one.
+func (ts *TimeSeriesT) DeleteRule(dest string) bool {
+	for i, r := range ts.Rules {
+		if r.Dest == dest {
+			ts.Rules = append(ts.Rules[:i], ts.Rules[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (ts *TimeSeriesT) Last() (Sample, bool) {
+	if len(ts.Samples) == 0 {
+		return Sample{}, false
+	}
+	return ts.Samples[len(ts.Samples)-1], true
+}
+
+func mergeDuplicate(policy DuplicatePolicy, old, v float64) (float64, error) {
+	switch policy {
+	case DupFirst:
+		return old, nil
+	case DupLast:
+		return v, nil
+	case DupMin:
+		return math.Min(old, v), nil
+	case DupMax:
+		return math.Max(old, v), nil
+	case DupSum:
+		return old + v, nil
+	}
+	return 0, ErrDuplicateSample
+}
+
+// Add inserts a sample, resolving an existing sample at the same timestamp
+// with policy, and drops samples that fell out of the retention period.
+// It returns the samples produced by compaction rules for their
+// destinations.
+func (ts *TimeSeriesT) Add(s Sample, policy DuplicatePolicy) (map[string][]Sample, error) {
+	if last, ok := ts.Last(); ok && ts.Retention > 0 && s.Ts < last.Ts-ts.Retention {
+		return nil, ErrSampleTooOld
+	}
+
+	i := ts.search(s.Ts)
+	duplicate := i < len(ts.Samples) && ts.Samples[i].Ts == s.Ts
+	if duplicate {
+		v, err := mergeDuplicate(policy, ts.Samples[i].Val, s.Val)
+		if err != nil {
+			return nil, err
+		}
+		ts.Samples[i].Val = v
+	} else {
+		ts.Samples = append(ts.Samples, Sample{})
+		copy(ts.Samples[i+1:], ts.Samples[i:])
+		ts.Samples[i] = s
+	}
+
+	compacted := make(map[string][]Sample)
+	for _, r := range ts.Rules {
+		start := s.Ts - s.Ts%r.Bucket
+		switch {
+		case !r.Open:
+			r.Start, r.Open = start, true
+		case start > r.Start:
+			compacted[r.Dest] = append(compacted[r.Dest], Sample{Ts: r.Start, Val: r.Acc.Value(r.Aggregation)})
+			r.Start, r.Acc = start, Aggregator{}
+		case start < r.Start:
+			// Samples for buckets that were already written are not
+			// compacted again.
+			continue
+		case duplicate:
+			// The sample replaced one already aggregated in the open
+			// bucket, which is aggregated again from the series.
+			r.Acc = ts.aggregate(r.Start, r.Start+r.Bucket)
+			continue
+		}
+		r.Acc.Add(ts.Samples[i].Val)
+	}
+
+	if last, _ := ts.Last(); ts.Retention > 0 {
+		if n := ts.search(last.Ts - ts.Retention); n > 0 {
+			ts.Samples = append([]Sample(nil), ts.Samples[n:]...)
+		}
+	}
+	return compacted, nil
+}
+
+// aggregate returns the aggregator of the samples with from <= ts < to.
+func (ts *TimeSeriesT) aggregate(from, to int64) Aggregator {
+	var acc Aggregator
+	for _, s := range ts.Samples[ts.search(from):ts.search(to)] {
+		acc.Add(s.Val)
+	}
+	return acc
+}
+
+// Range returns the samples with from <= ts <= to, aggregated into buckets
+// of bucket milliseconds unless agg is AggNone.
+func (ts *TimeSeriesT) Range(from, to int64, agg Aggregation, bucket int64) []Sample {
+	lo := ts.search(from)
+	hi := ts.search(to + 1)
+	if to == math.MaxInt64 {
+		hi = len(ts.Samples)
+	}
+	if lo >= hi {
+		return nil
+	}
+
+	samples := ts.Samples[lo:hi]
+	if agg == AggNone || bucket <= 0 {
+		return append([]Sample(nil), samples...)
+	}
+
+	var res []Sample
+	var acc Aggregator
+	start := samples[0].Ts - samples[0].Ts%bucket
+	for _, s := range samples {
+		if b := s.Ts - s.Ts%bucket; b != start {
+			res = append(res, Sample{Ts: start, Val: acc.Value(agg)})
+			start, acc = b, Aggregator{}
+		}
+		acc.Add(s.Val)
+	}
+	return append(res, Sample{Ts: start, Val: acc.Value(agg)})
+}
@@ -0,0 +1,129 @@
+package datatypes
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+type HeavyKeeperBucket struct {
+	Fingerprint uint32
+	Count       uint64
+}
+
+type TopKItem struct {
+	Item  string
+	Count uint64
+}
+
+// TopKT tracks the K most frequent items with the HeavyKeeper algorithm.
+// Each item maps to one bucket per row; a bucket held by another item is
+// decayed with probability Decay^count, so that only heavy hitters keep
+// their buckets.
+type TopKT struct {
+	K          uint64
+	Width      uint64
+	Depth      uint64
+	Decay      float64
+	Buckets    []HeavyKeeperBucket
+	Items      []TopKItem
+	Expiration int64
+}
+
+func NewTopK(k, width, depth uint64, decay float64, expiration int64) *TopKT {
+	return &TopKT{
+		K:          k,
+		Width:      width,
+		Depth:      depth,
+		Decay:      decay,
+		Buckets:    make([]HeavyKeeperBucket, width*depth),
+		Expiration: expiration,
+	}
+}
+
+func (t *TopKT) find(item string) int {
+	for i := range t.Items {
+		if t.Items[i].Item == item {
+			return i
+		}
+	}
+	return -1
+}
+
+func (t *TopKT) min() int {
+	m := 0
+	for i := range t.Items {
+		if t.Items[i].Count < t.Items[m].Count {
+			m = i
+		}
+	}
+	return m
+}
+
+// IncrBy increments the count of item by n. If item enters the top-k list
+// and pushes out another item, the expelled item is returned.
+func (t *TopKT) IncrBy(item string, n uint64) (string, bool) {
+	h1, h2 := filterHashes(item)
+	fp := uint32(h1 >> 32)
+
+	var count uint64
+	for row := uint64(0); row < t.Depth; row++ {
+		b := &t.Buckets[row*t.Width+(h1+row*h2)%t.Width]
+		switch {
+		case b.Count == 0:
+			b.Fingerprint = fp
+			b.Count = n
+		case b.Fingerprint == fp:
+			b.Count += n
+		default:
+			for i := uint64(0); i < n; i++ {
+				if rand.Float64() < math.Pow(t.Decay, float64(b.Count)) {
+					b.Count--
+					if b.Count == 0 {
+						b.Fingerprint = fp
+						b.Count = n - i
+						break
+					}
+				}
+			}
+		}
+		if b.Fingerprint == fp && b.Count > count {
+			count = b.Count
+		}
+	}
+
+	if i := t.find(item); i >= 0 {
+		if count > t.Items[i].Count {
+			t.Items[i].Count = count
+		}
+		return "", false
+	}
+	if uint64(len(t.Items)) < t.K {
+		t.Items = append(t.Items, TopKItem{Item: item, Count: count})
+		return "", false
+	}
+
+	m := t.min()
+	if count <= t.Items[m].Count {
+		return "", false
+	}
+	expelled := t.Items[m].Item
+	t.Items[m] = TopKItem{Item: item, Count: count}
+	return expelled, true
+}
+
+func (t *TopKT) Contains(item string) bool {
+	return t.find(item) >= 0
+}
+
+// List returns the top-k items, most frequent first.
+func (t *TopKT) List() []TopKItem {
+	items := append([]TopKItem(nil), t.Items...)
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Count != items[j].Count {
+			return items[i].Count > items[j].Count
+		}
+		return items[i].Item < items[j].Item
+	})
+	return items
+}
@@ -0,0 +1,426 @@
+package datatypes
+
+import (
+	"container/heap"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// VectorMetric mirrors pb.VectorMetric.
+type VectorMetric int
+
+const (
+	MetricCosine VectorMetric = iota
+	MetricDot
+	MetricL2
+)
+
+// VectorAlgorithm mirrors pb.VectorAlgorithm.
+type VectorAlgorithm int
+
+const (
+	VectorFlat VectorAlgorithm = iota
+	VectorHNSW
+)
+
+const (
+	DefaultHNSWM              = 16
+	DefaultHNSWEfConstruction = 200
+	DefaultHNSWEfSearch       = 10
+)
+
+// VectorNode is a vector with its links to its neighbours on every level
+// of the HNSW graph. Deleted nodes are kept in the graph, so that searches
+// can still go through them, until the index is rebuilt.
+type VectorNode struct {
+	ID      string
+	Vector  []float32
+	Links   [][]int32
+	Deleted bool
+}
+
+type VectorMatch struct {
+	ID       string
+	Distance float64
+}
+
+// VectorT is an index of fixed dimension vectors. A flat index compares
+// the query with every vector, while an HNSW index searches a layered
+// graph of nearest neighbours and returns approximate results.
+type VectorT struct {
+	Dim            int
+	Metric         VectorMetric
+	Algorithm      VectorAlgorithm
+	M              int
+	EfConstruction int
+	Nodes          []VectorNode
+	IDs            map[string]int32
+	Entry          int32
+	MaxLevel       int
+	Expiration     int64
+}
+
+func NewVector(dim int, metric VectorMetric, algorithm VectorAlgorithm, m, efConstruction int, expiration int64) *VectorT {
+	return &VectorT{
+		Dim:            dim,
+		Metric:         metric,
+		Algorithm:      algorithm,
+		M:              m,
+		EfConstruction: efConstruction,
+		IDs:            make(map[string]int32),
+		Entry:          -1,
+		Expiration:     expiration,
+	}
+}
+
+func (v *VectorT) Len() int {
+	return len(v.IDs)
+}
+
+// distance returns the distance between a and b, smaller being closer.
+// Cosine vectors are normalized when they are added, so their distance is
+// one minus their dot product.
+func (v *VectorT) distance(a, b []float32) float64 {
+	var sum float32
+	switch v.Metric {
+	case MetricL2:
+		for i := range a {
+			d := a[i] - b[i]
+			sum += d * d
+		}
+		return math.Sqrt(float64(sum))
+	case MetricDot:
+		for i := range a {
+			sum += a[i] * b[i]
+		}
+		return -float64(sum)
+	default:
+		for i := range a {
+			sum += a[i] * b[i]
+		}
+		return 1 - float64(sum)
+	}
+}
+
+func normalize(vec []float32) []float32 {
+	var norm float32
+	for _, x := range vec {
+		norm += x * x
+	}
+	out := make([]float32, len(vec))
+	if norm == 0 {
+		return out
+	}
+	n := float32(math.Sqrt(float64(norm)))
+	for i, x := range vec {
+		out[i] = x / n
+	}
+	return out
+}
+
+func (v *VectorT) prepare(vec []float32) []float32 {
+	if v.Metric == MetricCosine {
+		return normalize(vec)
+	}
+	return append([]float32(nil), vec...)
+}
+
+// Get returns the vector stored for id. Cosine vectors are returned
+// normalized.
+func (v *VectorT) Get(id string) ([]float32, bool) {
+	n, exists := v.IDs[id]
+	if !exists {
+		return nil, false
+	}
+	return v.Nodes[n].Vector, true
+}
+
+// Add sets the vector of id and reports whether it was added. The vector
+// must have v.Dim components.
+func (v *VectorT) Add(id string, vec []float32) bool {
+	_, exists := v.IDs[id]
+	if exists {
+		v.Delete(id)
+	}
+
+	n := int32(len(v.Nodes))
+	v.Nodes = append(v.Nodes, VectorNode{ID: id, Vector: v.prepare(vec)})
+	v.IDs[id] = n
+	if v.Algorithm == VectorHNSW {
+		v.insert(n)
+	}
+	return !exists
+}
+
+// Delete removes id and reports whether it existed. The index is rebuilt
+// once half of its nodes are deleted.
+func (v *VectorT) Delete(id string) bool {
+	n, exists := v.IDs[id]
+	if !exists {
+		return false
+	}
+	v.Nodes[n].Deleted = true
+	v.Nodes[n].Vector = nil
+	delete(v.IDs, id)
+
+	if len(v.Nodes) >= 2*len(v.IDs)+16 || len(v.IDs) == 0 {
+		v.rebuild()
+	}
+	return true
+}
+
+func (v *VectorT) rebuild() {
+	nodes := v.Nodes
+	v.Nodes = nil
+	v.IDs = make(map[string]int32)
+	v.Entry = -1
+	v.MaxLevel = 0
+	for _, node := range nodes {
+		if node.Deleted {
+			continue
+		}
+		n := int32(len(v.Nodes))
+		v.Nodes = append(v.Nodes, VectorNode{ID: node.ID, Vector: node.Vector})
+		v.IDs[node.ID] = n
+		if v.Algorithm == VectorHNSW {
+			v.insert(n)
+		}
+	}
+}
+
+// Search returns the k closest vectors to query, closest first. HNSW
+// indexes consider ef candidates, a larger ef giving better results, and
+// fall back to a flat search if exact is set.
+func (v *VectorT) Search(query []float32, k, ef int, exact bool) []VectorMatch {
+	if v.Metric == MetricCosine {
+		query = normalize(query)
+	}
+	if v.Algorithm == VectorFlat || exact {
+		return v.flatSearch(query, k)
+	}
+	if v.Entry < 0 || k <= 0 {
+		return nil
+	}
+
+	if ef < k {
+		ef = k
+	}
+	ep := v.Entry
+	for level := v.MaxLevel; level > 0; level-- {
+		ep = v.searchLayer(query, []int32{ep}, 1, level)[0].node
+	}
+	found := v.searchLayer(query, []int32{ep}, ef, 0)
+
+	var matches []VectorMatch
+	for _, c := range found {
+		if v.Nodes[c.node].Deleted {
+			continue
+		}
+		matches = append(matches, VectorMatch{ID: v.Nodes[c.node].ID, Distance: c.dist})
+		if len(matches) == k {
+			break
+		}
+	}
+	return matches
+}
+
+func (v *VectorT) flatSearch(query []float32, k int) []VectorMatch {
+	var matches []VectorMatch
+	for _, node := range v.Nodes {
+		if !node.Deleted {
+			matches = append(matches, VectorMatch{ID: node.ID, Distance: v.distance(query, node.Vector)})
+		}
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Distance != matches[j].Distance {
+			return matches[i].Distance < matches[j].Distance
+		}
+		return matches[i].ID < matches[j].ID
+	})
+	if len(matches) > k {
+		matches = matches[:k]
+	}
+	return matches
+}
+
+type candidate struct {
+	node int32
+	dist float64
+}
+
+// candidateHeap is a min-heap of candidates, or a max-heap if max is set.
+type candidateHeap struct {
+	items []candidate
+	max   bool
+}
+
+func (h *candidateHeap) Len() int { return len(h.items) }
+func (h *candidateHeap) Less(i, j int) bool {
+	if h.max {
+		return h.items[i].dist > h.items[j].dist
+	}
+	return h.items[i].dist < h.items[j].dist
+}
+func (h *candidateHeap) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *candidateHeap) Push(x interface{}) { h.items = append(h.items, x.(candidate)) }
+func (h *candidateHeap) Pop() interface{} {
+	x := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return x
+}
+
+// searchLayer returns the ef closest nodes to query found by a best first
+// search from entries on level, closest first.
+func (v *VectorT) searchLayer(query []float32, entries []int32, ef, level int) []candidate {
+	visited := make(map[int32]bool)
+	candidates := &candidateHeap{}
+	results := &candidateHeap{max: true}
+	for _, e := range entries {
+		visited[e] = true
+		c := candidate{node: e, dist: v.nodeDistance(query, e)}
+		heap.Push(candidates, c)
+		heap.Push(results, c)
+	}
+
+	for candidates.Len() > 0 {
+		c := heap.Pop(candidates).(candidate)
+		if results.Len() >= ef && c.dist > results.items[0].dist {
+			break
+		}
+		for _, n := range v.Nodes[c.node].Links[level] {
+			if visited[n] {
+				continue
+			}
+			visited[n] = true
+			d := v.nodeDistance(query, n)
+			if results.Len() < ef || d < results.items[0].dist {
+				heap.Push(candidates, candidate{node: n, dist: d})
+				heap.Push(results, candidate{node: n, dist: d})
+				if results.Len() > ef {
+					heap.Pop(results)
+				}
+			}
+		}
+	}
+
+	found := make([]candidate, results.Len())
+	for i := len(found) - 1; i >= 0; i-- {
+		found[i] = heap.Pop(results).(candidate)
+	}
+	return found
+}
+
+// nodeDistance returns the distance from query to node n. Deleted nodes
+// no longer hold their vector and are only kept to connect the graph, so
+// they are considered as far as possible.
+func (v *VectorT) nodeDistance(query []float32, n int32) float64 {
+	if v.Nodes[n].Deleted {
+		return math.MaxFloat64
+	}
+	return v.distance(query, v.Nodes[n].Vector)
+}
+
+func (v *VectorT) maxLinks(level int) int {
+	if level == 0 {
+		return 2 * v.M
+	}
+	return v.M
+}
+
+// selectNeighbors picks up to m of candidates, closest first, skipping
+// those closer to an already selected neighbour than to the new node so
+// that links spread in every direction.
+func (v *VectorT) selectNeighbors(candidates []candidate, m int) []int32 {
+	var selected []int32
+	var skipped []int32
+	for _, c := range candidates {
+		if len(selected) == m {
+			break
+		}
+		if v.Nodes[c.node].Deleted {
+			continue
+		}
+		keep := true
+		for _, s := range selected {
+			if v.distance(v.Nodes[c.node].Vector, v.Nodes[s].Vector) < c.dist {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			selected = append(selected, c.node)
+		} else {
+			skipped = append(skipped, c.node)
+		}
+	}
+	for _, n := range skipped {
+		if len(selected) == m {
+			break
+		}
+		selected = append(selected, n)
+	}
+	return selected
+}
+
+// insert links node n into the HNSW graph at a random level.
+func (v *VectorT) insert(n int32) {
+	level := int(-math.Log(1-rand.Float64()) / math.Log(float64(v.M)))
+	node := &v.Nodes[n]
+	node.Links = make([][]int32, level+1)
+
+	if v.Entry < 0 {
+		v.Entry = n
+		v.MaxLevel = level
+		return
+	}
+
+	ep := v.Entry
+	for l := v.MaxLevel; l > level; l-- {
+		ep = v.searchLayer(node.Vector, []int32{ep}, 1, l)[0].node
+	}
+
+	entries := []int32{ep}
+	for l := min(level, v.MaxLevel); l >= 0; l-- {
+		found := v.searchLayer(node.Vector, entries, v.EfConstruction, l)
+		neighbors := v.selectNeighbors(found, v.M)
+		v.Nodes[n].Links[l] = neighbors
+
+		for _, nb := range neighbors {
+			links := append(v.Nodes[nb].Links[l], n)
+			if len(links) > v.maxLinks(l) {
+				links = v.shrink(nb, links, l)
+			}
+			v.Nodes[nb].Links[l] = links
+		}
+
+		entries = entries[:0]
+		for _, c := range found {
+			entries = append(entries, c.node)
+		}
+	}
+
+	if level > v.MaxLevel {
+		v.Entry = n
+		v.MaxLevel = level
+	}
+}
+
+// shrink selects the links of node nb to keep on level.
+func (v *VectorT) shrink(nb int32, links []int32, level int) []int32 {
+	candidates := make([]candidate, 0, len(links))
+	for _, l := range links {
+		candidates = append(candidates, candidate{node: l, dist: v.nodeDistance(v.Nodes[nb].Vector, l)})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].dist < candidates[j].dist
+	})
+	return v.selectNeighbors(candidates, v.maxLinks(level))
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
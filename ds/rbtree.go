@@ -1,9 +1,5 @@
 package ds
 
-import (
-	dt "github.com/shanukun/cash/datatypes"
-)
-
 const (
 	RED   bool = true
 	BLACK bool = false
@@ -11,7 +7,7 @@ const (
 
 type Node struct {
 	key    string
-	Value  dt.AnyT
+	Value  interface{}
 	color  bool
 	parent *Node
 	left   *Node
@@ -66,7 +62,7 @@ func (tree *RBTree) rightRotate(x *Node) {
 	x.parent = y
 }
 
-func (tree *RBTree) Insert(key string, value dt.AnyT) {
+func (tree *RBTree) Insert(key string, value interface{}) {
 	z := &Node{
 		key:    key,
 		Value:  value,
@@ -284,7 +280,7 @@ func (tree *RBTree) search(key string) *Node {
 	return x
 }
 
-func (tree *RBTree) Find(key string) (dt.AnyT, bool) {
+func (tree *RBTree) Find(key string) (interface{}, bool) {
 	item := tree.search(key)
 	if item != nil && item.key == key {
 		return item.Value, true
@@ -293,8 +289,29 @@ func (tree *RBTree) Find(key string) (dt.AnyT, bool) {
 	return nil, false
 }
 
+// Ascend calls fn for every key >= from in ascending order until fn
+// returns false.
+func (tree *RBTree) Ascend(from string, fn func(key string, value interface{}) bool) {
+	tree.ascend(tree.Root, from, fn)
+}
+
+func (tree *RBTree) ascend(x *Node, from string, fn func(key string, value interface{}) bool) bool {
+	if x == tree.Nil {
+		return true
+	}
+	if x.key >= from {
+		if !tree.ascend(x.left, from, fn) {
+			return false
+		}
+		if !fn(x.key, x.Value) {
+			return false
+		}
+	}
+	return tree.ascend(x.right, from, fn)
+}
+
 func InitRBTree() *RBTree {
-	val := dt.AnyT("99999")
+	val := interface{}("99999")
 	nilNode := &Node{
 		key:    "99999",
 		Value:  val,
@@ -0,0 +1,368 @@
+package dynamo
+
+import (
+	"context"
+	"crypto/md5"
+	"sort"
+	"time"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	defaultAntiEntropyInterval = time.Minute
+	defaultRepairRate          = 1000
+
+	// fanout is the number of ranges a range which differs is split into.
+	fanout = 16
+	// leafSize is the number of keys under which ranges which differ are
+	// compared key by key.
+	leafSize = 64
+	// maxRanges bounds the ranges of a request.
+	maxRanges   = 256
+	repairBatch = 100
+	// roundTTL is how long the digests of the round of a peer are kept
+	// after its last request.
+	roundTTL = time.Minute
+)
+
+type keyDigest struct {
+	key    string
+	clock  *pb.VectorClock
+	digest [md5.Size]byte
+}
+
+// digest hashes the version of key, so that replicas with the same
+// version get the same digest.
+func digest(key string, clock *pb.VectorClock) [md5.Size]byte {
+	data, _ := proto.MarshalOptions{Deterministic: true}.Marshal(clock)
+	return md5.Sum(append([]byte(key+"\x00"), data...))
+}
+
+// shared reports whether key is kept by both this node and peer.
+func (n *Node) shared(key, peer string) bool {
+	nodes := n.ring.Nodes(key, n.cfg.Replicas)
+	return contains(nodes, n.cfg.ID) && contains(nodes, peer)
+}
+
+// digests returns the digests of the versioned keys kept by both this node
+// and peer, in key order. Live keys come in the order of the store, and
+// the keys deleted or expired are merged in.
+func (n *Node) digests(peer string) []keyDigest {
+	var live []string
+	n.cfg.Store.ScanKeys(func(key string) bool {
+		live = append(live, key)
+		return true
+	})
+	n.mu.Lock()
+	clocks := make(map[string]*pb.VectorClock, len(n.clocks))
+	for key, clock := range n.clocks {
+		clocks[key] = clock
+	}
+	n.mu.Unlock()
+
+	var dead []string
+	for key := range clocks {
+		if i := sort.SearchStrings(live, key); i == len(live) || live[i] != key {
+			dead = append(dead, key)
+		}
+	}
+	sort.Strings(dead)
+
+	var digests []keyDigest
+	add := func(key string) {
+		clock, ok := clocks[key]
+		if ok && n.shared(key, peer) {
+			digests = append(digests, keyDigest{key: key, clock: clock, digest: digest(key, clock)})
+		}
+	}
+	i, j := 0, 0
+	for i < len(live) || j < len(dead) {
+		if j == len(dead) || (i < len(live) && live[i] < dead[j]) {
+			add(live[i])
+			i++
+		} else {
+			add(dead[j])
+			j++
+		}
+	}
+	return digests
+}
+
+// peerRound holds the digests a peer compares its keys with during one
+// anti-entropy round, so that each level of the tree does not scan the
+// store again.
+type peerRound struct {
+	round    int64
+	digests  []keyDigest
+	lastUsed time.Time
+}
+
+// roundDigests returns the digests of the keys shared with peer, as of the
+// first request of its round.
+func (n *Node) roundDigests(peer string, round int64) []keyDigest {
+	n.mu.Lock()
+	r, ok := n.rounds[peer]
+	if ok && round != 0 && r.round == round {
+		r.lastUsed = time.Now()
+		n.mu.Unlock()
+		return r.digests
+	}
+	n.mu.Unlock()
+
+	digests := n.digests(peer)
+	n.mu.Lock()
+	n.rounds[peer] = &peerRound{round: round, digests: digests, lastUsed: time.Now()}
+	n.mu.Unlock()
+	return digests
+}
+
+// forgetRounds drops the digests of rounds which are over.
+func (n *Node) forgetRounds() {
+	limit := time.Now().Add(-roundTTL)
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for peer, r := range n.rounds {
+		if r.lastUsed.Before(limit) {
+			delete(n.rounds, peer)
+		}
+	}
+}
+
+// span returns the digests of the keys in r, from r.Start included to
+// r.End excluded, an empty end meaning no bound.
+func span(digests []keyDigest, r *pb.KeyRange) []keyDigest {
+	lo := sort.Search(len(digests), func(i int) bool {
+		return digests[i].key >= r.Start
+	})
+	hi := len(digests)
+	if r.End != "" {
+		hi = sort.Search(len(digests), func(i int) bool {
+			return digests[i].key >= r.End
+		})
+	}
+	if hi < lo {
+		hi = lo
+	}
+	return digests[lo:hi]
+}
+
+func rangeHash(digests []keyDigest) *pb.RangeHash {
+	h := md5.New()
+	for _, d := range digests {
+		h.Write(d.digest[:])
+	}
+	return &pb.RangeHash{Hash: h.Sum(nil), Count: int64(len(digests))}
+}
+
+// split splits r in up to fanout ranges holding as many of the keys of
+// digests each.
+func split(digests []keyDigest, r *pb.KeyRange) []*pb.KeyRange {
+	keys := span(digests, r)
+	var ranges []*pb.KeyRange
+	start := r.Start
+	for i := 1; i < fanout; i++ {
+		k := keys[i*len(keys)/fanout].key
+		if k <= start {
+			continue
+		}
+		ranges = append(ranges, &pb.KeyRange{Start: start, End: k})
+		start = k
+	}
+	return append(ranges, &pb.KeyRange{Start: start, End: r.End})
+}
+
+func (n *Node) addStats(fn func(s *pb.AntiEntropyStats)) {
+	n.mu.Lock()
+	fn(n.stats)
+	n.mu.Unlock()
+}
+
+// wait waits for the rate limit to allow repairing count keys, and reports
+// whether the node is still running.
+func (n *Node) wait(count int) bool {
+	select {
+	case <-n.stopped:
+		return false
+	case <-time.After(time.Duration(count) * time.Second / time.Duration(n.cfg.RepairRate)):
+		return true
+	}
+}
+
+// runAntiEntropy compares the keys shared with every other node in turn,
+// every cfg.AntiEntropyInterval.
+func (n *Node) runAntiEntropy() {
+	ticker := time.NewTicker(n.cfg.AntiEntropyInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-n.stopped:
+			return
+		case <-ticker.C:
+		}
+		start := time.Now()
+		for _, m := range n.cfg.Members {
+			if m.Id != n.cfg.ID {
+				n.compare(m.Id)
+			}
+		}
+		n.addStats(func(s *pb.AntiEntropyStats) {
+			s.Rounds++
+			s.LastRoundMs = time.Since(start).Milliseconds()
+		})
+	}
+}
+
+// compare walks down the hash tree of the key ranges shared with peer:
+// ranges whose hashes differ are split, until they are small enough to
+// compare the versions of their keys, and only the keys which differ are
+// repaired. Both nodes hash the keys they had at the start of the round.
+func (n *Node) compare(peer string) error {
+	digests := n.digests(peer)
+	round := time.Now().UnixNano()
+	pending := []*pb.KeyRange{{}}
+	for len(pending) > 0 {
+		ranges := pending
+		if len(ranges) > maxRanges {
+			ranges = ranges[:maxRanges]
+		}
+		pending = pending[len(ranges):]
+
+		ctx, cancel := context.WithTimeout(context.Background(), n.cfg.Timeout)
+		resp, err := n.cfg.Transport.RangeHashes(ctx, n.addresses[peer], &pb.DynamoRangeRequest{Peer: n.cfg.ID, Ranges: ranges, Round: round})
+		cancel()
+		if err != nil {
+			return err
+		}
+
+		var leaves []*pb.KeyRange
+		for i, r := range ranges {
+			if i >= len(resp.Hashes) {
+				break
+			}
+			mine, theirs := rangeHash(span(digests, r)), resp.Hashes[i]
+			if proto.Equal(mine, theirs) {
+				continue
+			}
+			if mine.Count <= leafSize {
+				leaves = append(leaves, r)
+			} else {
+				pending = append(pending, split(digests, r)...)
+			}
+		}
+		n.addStats(func(s *pb.AntiEntropyStats) {
+			s.RangesCompared += int64(len(ranges))
+		})
+		if len(leaves) > 0 {
+			if err := n.compareKeys(peer, round, digests, leaves); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// compareKeys repairs the keys of ranges whose versions differ on peer.
+// Keys kept by either node are compared.
+func (n *Node) compareKeys(peer string, round int64, digests []keyDigest, ranges []*pb.KeyRange) error {
+	ctx, cancel := context.WithTimeout(context.Background(), n.cfg.Timeout)
+	resp, err := n.cfg.Transport.RangeDigests(ctx, n.addresses[peer], &pb.DynamoRangeRequest{Peer: n.cfg.ID, Ranges: ranges, Round: round})
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	theirs := make(map[string]*pb.VectorClock)
+	for _, v := range resp.Versions {
+		theirs[v.Key] = v.Clock
+	}
+	var differ []string
+	compared := 0
+	for _, r := range ranges {
+		for _, d := range span(digests, r) {
+			clock, ok := theirs[d.key]
+			if !ok || !equal(clock, d.clock) {
+				differ = append(differ, d.key)
+			}
+			delete(theirs, d.key)
+			compared++
+		}
+	}
+	for key := range theirs {
+		differ = append(differ, key)
+		compared++
+	}
+	n.addStats(func(s *pb.AntiEntropyStats) {
+		s.KeysCompared += int64(compared)
+	})
+	sort.Strings(differ)
+	return n.repair(peer, differ)
+}
+
+// repair brings keys up to date on both this node and peer, in batches
+// limited by cfg.RepairRate.
+func (n *Node) repair(peer string, keys []string) error {
+	for len(keys) > 0 {
+		batch := keys
+		if len(batch) > repairBatch {
+			batch = batch[:repairBatch]
+		}
+		keys = keys[len(batch):]
+		if !n.wait(len(batch)) {
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), n.cfg.Timeout)
+		resp, err := n.cfg.Transport.Fetch(ctx, n.addresses[peer], &pb.DynamoKeys{Keys: batch})
+		cancel()
+		if err != nil {
+			return err
+		}
+		pulled, err := n.apply(resp.Versions)
+		if err != nil {
+			return err
+		}
+
+		local, err := n.versions(batch)
+		if err != nil {
+			return err
+		}
+		ctx, cancel = context.WithTimeout(context.Background(), n.cfg.Timeout)
+		pushed, err := n.cfg.Transport.Store(ctx, n.addresses[peer], &pb.DynamoStoreRequest{Versions: local})
+		cancel()
+		if err != nil {
+			return err
+		}
+		n.addStats(func(s *pb.AntiEntropyStats) {
+			s.KeysPulled += int64(pulled)
+			s.KeysPushed += pushed.Count
+		})
+	}
+	return nil
+}
+
+// DynamoRangeHashes returns the hashes of the keys of each range kept by
+// both this node and req.Peer. The requests of a round of req.Peer share
+// the digests built for its first request.
+func (n *Node) DynamoRangeHashes(ctx context.Context, req *pb.DynamoRangeRequest) (*pb.RangeHashes, error) {
+	digests := n.roundDigests(req.Peer, req.Round)
+	resp := &pb.RangeHashes{}
+	for _, r := range req.Ranges {
+		resp.Hashes = append(resp.Hashes, rangeHash(span(digests, r)))
+	}
+	return resp, nil
+}
+
+// DynamoRangeDigests returns the clocks of the keys of the ranges kept by
+// both this node and req.Peer, without their values.
+func (n *Node) DynamoRangeDigests(ctx context.Context, req *pb.DynamoRangeRequest) (*pb.DynamoVersions, error) {
+	digests := n.roundDigests(req.Peer, req.Round)
+	resp := &pb.DynamoVersions{}
+	for _, r := range req.Ranges {
+		for _, d := range span(digests, r) {
+			resp.Versions = append(resp.Versions, &pb.DynamoVersion{Key: d.key, Clock: d.clock})
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,188 @@
+package dynamo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"testing"
+
+	pb "github.com/shanukun/cash/cash_proto"
+)
+
+// mapStore is a Store counting the scans of its keys.
+type mapStore struct {
+	mu      sync.Mutex
+	entries map[string]*pb.KeyEntry
+	scans   int
+}
+
+func newMapStore() *mapStore {
+	return &mapStore{entries: make(map[string]*pb.KeyEntry)}
+}
+
+func (s *mapStore) Exists(key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.entries[key]
+	return ok
+}
+
+func (s *mapStore) Entries(keys []string) ([]*pb.KeyEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var entries []*pb.KeyEntry
+	for _, key := range keys {
+		if e, ok := s.entries[key]; ok {
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+func (s *mapStore) ApplyChanges(changes *pb.CacheChanges) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, key := range changes.Deleted {
+		delete(s.entries, key)
+	}
+	for _, e := range changes.Entries {
+		s.entries[e.Key] = e
+	}
+	return nil
+}
+
+func (s *mapStore) ScanKeys(fn func(key string) bool) {
+	s.mu.Lock()
+	s.scans++
+	var keys []string
+	for key := range s.entries {
+		keys = append(keys, key)
+	}
+	s.mu.Unlock()
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !fn(key) {
+			return
+		}
+	}
+}
+
+// directTransport calls the nodes of the same process.
+type directTransport map[string]*Node
+
+func (t directTransport) Fetch(ctx context.Context, address string, req *pb.DynamoKeys) (*pb.DynamoVersions, error) {
+	return t[address].DynamoFetch(ctx, req)
+}
+
+func (t directTransport) Store(ctx context.Context, address string, req *pb.DynamoStoreRequest) (*pb.Count, error) {
+	return t[address].DynamoStore(ctx, req)
+}
+
+func (t directTransport) RangeHashes(ctx context.Context, address string, req *pb.DynamoRangeRequest) (*pb.RangeHashes, error) {
+	return t[address].DynamoRangeHashes(ctx, req)
+}
+
+func (t directTransport) RangeDigests(ctx context.Context, address string, req *pb.DynamoRangeRequest) (*pb.DynamoVersions, error) {
+	return t[address].DynamoRangeDigests(ctx, req)
+}
+
+func (t directTransport) Forward(ctx context.Context, address, method string, req, resp interface{}) error {
+	return fmt.Errorf("Forwarding %s is not supported", method)
+}
+
+// newPair returns two nodes keeping every key, with their stores.
+func newPair(t *testing.T) (*Node, *Node, *mapStore, *mapStore) {
+	t.Helper()
+	transport := directTransport{}
+	members := []*pb.DynamoMember{{Id: "a", Address: "a"}, {Id: "b", Address: "b"}}
+	var nodes []*Node
+	var stores []*mapStore
+	for _, m := range members {
+		store := newMapStore()
+		n, err := New(Config{
+			ID:                  m.Id,
+			Address:             m.Address,
+			Members:             members,
+			Replicas:            2,
+			AntiEntropyInterval: -1,
+			RepairRate:          1 << 20,
+			Store:               store,
+			Transport:           transport,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		transport[m.Address] = n
+		nodes = append(nodes, n)
+		stores = append(stores, store)
+	}
+	return nodes[0], nodes[1], stores[0], stores[1]
+}
+
+// write stores key on n as written by writer.
+func write(t *testing.T, n *Node, key, writer string) {
+	t.Helper()
+	_, err := n.apply([]*pb.DynamoVersion{{
+		Key:   key,
+		Entry: &pb.KeyEntry{Key: key, Value: []byte(writer)},
+		Clock: &pb.VectorClock{Counters: map[string]int64{writer: 1}, Timestamp: 1, Node: writer},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAntiEntropyScansOncePerRound(t *testing.T) {
+	a, b, storeA, storeB := newPair(t)
+	for i := 0; i < 5000; i++ {
+		key := fmt.Sprintf("key%05d", i)
+		write(t, a, key, "a")
+		if i%500 != 0 {
+			write(t, b, key, "a")
+		}
+	}
+	if err := a.compare("b"); err != nil {
+		t.Fatal(err)
+	}
+	if storeA.scans != 1 || storeB.scans != 1 {
+		t.Errorf("round scanned the stores %d and %d times, want once each", storeA.scans, storeB.scans)
+	}
+	if ranges := a.State().AntiEntropy.RangesCompared; ranges < 2 {
+		t.Errorf("%d ranges compared, want several levels", ranges)
+	}
+	if len(storeB.entries) != 5000 {
+		t.Errorf("b holds %d keys after repair, want 5000", len(storeB.entries))
+	}
+
+	// The next round starts from the repaired keys.
+	if err := a.compare("b"); err != nil {
+		t.Fatal(err)
+	}
+	if storeB.scans != 2 {
+		t.Errorf("b scanned its store %d times after two rounds, want 2", storeB.scans)
+	}
+}
+
+func TestAntiEntropyCountsKeysOfBothNodes(t *testing.T) {
+	a, b, _, _ := newPair(t)
+	for i := 0; i < 40; i++ {
+		key := fmt.Sprint("key", i)
+		switch {
+		case i < 30:
+			write(t, a, key, "a")
+			write(t, b, key, "a")
+		case i < 35:
+			write(t, a, key, "a")
+		default:
+			write(t, b, key, "b")
+		}
+	}
+	if err := a.compare("b"); err != nil {
+		t.Fatal(err)
+	}
+	st := a.State().AntiEntropy
+	if st.KeysCompared != 40 || st.KeysPulled != 5 || st.KeysPushed != 5 {
+		t.Errorf("compared %d, pulled %d and pushed %d keys, want 40, 5 and 5", st.KeysCompared, st.KeysPulled, st.KeysPushed)
+	}
+}
@@ -0,0 +1,70 @@
+package dynamo
+
+import (
+	pb "github.com/shanukun/cash/cash_proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// descends reports whether clock a has seen every write clock b has seen.
+// A nil clock, of a key never written, is descended by every clock.
+func descends(a, b *pb.VectorClock) bool {
+	if b == nil {
+		return true
+	}
+	if a == nil {
+		return len(b.Counters) == 0
+	}
+	for node, n := range b.Counters {
+		if a.Counters[node] < n {
+			return false
+		}
+	}
+	return true
+}
+
+// newer reports whether the version with clock a replaces the version
+// with clock b. When neither descends from the other, the versions were
+// written concurrently and the last writer wins.
+func newer(a, b *pb.VectorClock) bool {
+	da, db := descends(a, b), descends(b, a)
+	switch {
+	case da && db:
+		return false
+	case da:
+		return true
+	case db:
+		return false
+	}
+	if a.Timestamp != b.Timestamp {
+		return a.Timestamp > b.Timestamp
+	}
+	return a.Node > b.Node
+}
+
+// merge returns a clock which descends both a and b, keeping the timestamp
+// and writer of a.
+func merge(a, b *pb.VectorClock) *pb.VectorClock {
+	c := &pb.VectorClock{Counters: make(map[string]int64)}
+	if a != nil {
+		c.Timestamp, c.Node = a.Timestamp, a.Node
+	}
+	for _, v := range []*pb.VectorClock{a, b} {
+		if v == nil {
+			continue
+		}
+		for node, n := range v.Counters {
+			if n > c.Counters[node] {
+				c.Counters[node] = n
+			}
+		}
+	}
+	return c
+}
+
+// equal reports whether clocks a and b are the same version.
+func equal(a, b *pb.VectorClock) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return proto.Equal(a, b)
+}
@@ -0,0 +1,700 @@
+// Package dynamo replicates keys without a leader, as in Dynamo. Every key
+// is kept by the first N nodes of its preference list on a consistent hash
+// ring, and any of them coordinates the requests for the key: it reads the
+// versions of R replicas, runs the request, then sends the new version to
+// the other replicas and answers once W of them have it. Versions carry
+// vector clocks, and concurrent versions are resolved by last writer wins.
+// Reads repair the stale replicas they find, and writes for unreachable
+// replicas are kept by the next nodes of the ring as hints, handed off
+// once the replica is back. Anti-entropy compares Merkle trees of the key
+// ranges shared by replicas in the background, repairing the keys which
+// neither reads nor hints have brought up to date.
+package dynamo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/shanukun/cash/cash_proto"
+	"github.com/shanukun/cash/cluster"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrInvalidQuorum = errors.New("Quorums must be between 1 and the number of replicas")
+	ErrNoMember      = errors.New("Node is not a member of the ring")
+)
+
+const (
+	defaultReplicas        = 3
+	defaultTimeout         = time.Second
+	defaultHandoffInterval = time.Second
+	// tombstoneTTL is how long the clocks of deleted keys are kept, for
+	// replicas which missed the delete not to bring the keys back.
+	tombstoneTTL = time.Hour
+)
+
+// Metadata of requests.
+const (
+	writeQuorumKey = "cash-w"
+	readQuorumKey  = "cash-r"
+	// coordinateKey marks requests forwarded to a replica, which
+	// coordinates them rather than forwarding them again.
+	coordinateKey = "cash-coordinate"
+)
+
+// WithQuorum returns a context for requests which need w replicas to
+// acknowledge writes and r replicas to answer reads, instead of the
+// defaults of the node. Zero keeps the default.
+func WithQuorum(ctx context.Context, w, r int) context.Context {
+	if w > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, writeQuorumKey, strconv.Itoa(w))
+	}
+	if r > 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, readQuorumKey, strconv.Itoa(r))
+	}
+	return ctx
+}
+
+// Store is the local store of the values of keys.
+type Store interface {
+	Exists(key string) bool
+	// Entries encodes the values at keys, skipping missing keys.
+	Entries(keys []string) ([]*pb.KeyEntry, error)
+	ApplyChanges(changes *pb.CacheChanges) error
+	// ScanKeys calls fn for every key in order until fn returns false.
+	ScanKeys(fn func(key string) bool)
+}
+
+type Config struct {
+	ID      string
+	Address string
+	// Members are the nodes of the ring, this one included.
+	Members []*pb.DynamoMember
+	// Replicas is the number of nodes keeping each key, N.
+	Replicas int
+	// WriteQuorum and ReadQuorum are the default W and R, a majority of
+	// the replicas unless set.
+	WriteQuorum int
+	ReadQuorum  int
+	// Timeout bounds each request to another node.
+	Timeout         time.Duration
+	HandoffInterval time.Duration
+	// AntiEntropyInterval is the time between anti-entropy rounds, which
+	// are disabled if negative. RepairRate bounds the keys repaired by
+	// second.
+	AntiEntropyInterval time.Duration
+	RepairRate          int
+
+	Store     Store
+	Transport Transport
+}
+
+// Node is a member of the ring.
+type Node struct {
+	pb.UnimplementedDynamoServiceServer
+
+	cfg       Config
+	ring      *Ring
+	addresses map[string]string
+
+	// mu makes the change of a value and of its clock atomic.
+	mu     sync.Mutex
+	clocks map[string]*pb.VectorClock
+	// hints holds versions meant for other nodes, by node and key.
+	hints map[string]map[string]*pb.DynamoVersion
+	// rounds holds the digests of the anti-entropy round of each peer.
+	rounds  map[string]*peerRound
+	stats   *pb.AntiEntropyStats
+	stopped chan struct{}
+}
+
+// New returns the node cfg.ID of the ring of cfg.Members.
+func New(cfg Config) (*Node, error) {
+	if len(cfg.Members) == 0 {
+		cfg.Members = []*pb.DynamoMember{{Id: cfg.ID, Address: cfg.Address}}
+	}
+	if cfg.Replicas == 0 {
+		cfg.Replicas = defaultReplicas
+		if len(cfg.Members) < cfg.Replicas {
+			cfg.Replicas = len(cfg.Members)
+		}
+	}
+	if cfg.WriteQuorum == 0 {
+		cfg.WriteQuorum = cfg.Replicas/2 + 1
+	}
+	if cfg.ReadQuorum == 0 {
+		cfg.ReadQuorum = cfg.Replicas/2 + 1
+	}
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	if cfg.HandoffInterval == 0 {
+		cfg.HandoffInterval = defaultHandoffInterval
+	}
+	if cfg.AntiEntropyInterval == 0 {
+		cfg.AntiEntropyInterval = defaultAntiEntropyInterval
+	}
+	if cfg.RepairRate == 0 {
+		cfg.RepairRate = defaultRepairRate
+	}
+	if cfg.Transport == nil {
+		cfg.Transport = NewGRPCTransport()
+	}
+
+	n := &Node{
+		cfg:       cfg,
+		addresses: make(map[string]string),
+		clocks:    make(map[string]*pb.VectorClock),
+		hints:     make(map[string]map[string]*pb.DynamoVersion),
+		rounds:    make(map[string]*peerRound),
+		stats:     &pb.AntiEntropyStats{},
+		stopped:   make(chan struct{}),
+	}
+	var ids []string
+	for _, m := range cfg.Members {
+		n.addresses[m.Id] = m.Address
+		ids = append(ids, m.Id)
+	}
+	if _, ok := n.addresses[cfg.ID]; !ok {
+		return nil, ErrNoMember
+	}
+	if cfg.Replicas > len(ids) || !n.validQuorum(cfg.WriteQuorum) || !n.validQuorum(cfg.ReadQuorum) {
+		return nil, ErrInvalidQuorum
+	}
+	n.ring = NewRing(ids)
+	return n, nil
+}
+
+func (n *Node) validQuorum(q int) bool {
+	return q >= 1 && q <= n.cfg.Replicas
+}
+
+// Start hands hints off, forgets old tombstones and runs anti-entropy in
+// the background.
+func (n *Node) Start() {
+	go n.run()
+	if n.cfg.AntiEntropyInterval > 0 {
+		go n.runAntiEntropy()
+	}
+}
+
+func (n *Node) Stop() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	select {
+	case <-n.stopped:
+	default:
+		close(n.stopped)
+	}
+}
+
+func (n *Node) run() {
+	ticker := time.NewTicker(n.cfg.HandoffInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-n.stopped:
+			return
+		case <-ticker.C:
+		}
+		n.handoff()
+		n.forgetTombstones()
+		n.forgetRounds()
+	}
+}
+
+// quorum returns the W and R of a request.
+func (n *Node) quorum(ctx context.Context) (int, int, error) {
+	w, r := n.cfg.WriteQuorum, n.cfg.ReadQuorum
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, q := range []struct {
+		key string
+		v   *int
+	}{{writeQuorumKey, &w}, {readQuorumKey, &r}} {
+		values := md.Get(q.key)
+		if len(values) == 0 {
+			continue
+		}
+		v, err := strconv.Atoi(values[0])
+		if err != nil || !n.validQuorum(v) {
+			return 0, 0, status.Error(codes.InvalidArgument, ErrInvalidQuorum.Error())
+		}
+		*q.v = v
+	}
+	return w, r, nil
+}
+
+func noQuorum(what string, got, need int) error {
+	return status.Errorf(codes.Unavailable, "NOQUORUM %d of %d replicas %s", got, need, what)
+}
+
+// preference returns the replicas of keys, which must all be kept by the
+// same nodes.
+func (n *Node) preference(keys []string) ([]string, error) {
+	var ids []string
+	for i, key := range keys {
+		nodes := n.ring.Nodes(key, n.cfg.Replicas)
+		if i > 0 && !sameNodes(ids, nodes) {
+			return nil, status.Error(codes.InvalidArgument, "CROSSNODE Keys in request are not kept by the same nodes")
+		}
+		ids = nodes
+	}
+	return ids, nil
+}
+
+func sameNodes(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	set := make(map[string]bool)
+	for _, id := range a {
+		set[id] = true
+	}
+	for _, id := range b {
+		if !set[id] {
+			return false
+		}
+	}
+	return true
+}
+
+// fallbacks returns the nodes after the replicas of key on the ring, which
+// keep hints for unreachable replicas.
+func (n *Node) fallbacks(key string) []string {
+	return n.ring.Nodes(key, len(n.addresses))[n.cfg.Replicas:]
+}
+
+func (n *Node) others(ids []string) []string {
+	var others []string
+	for _, id := range ids {
+		if id != n.cfg.ID {
+			others = append(others, id)
+		}
+	}
+	return others
+}
+
+func contains(ids []string, id string) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+	return false
+}
+
+func dedupe(keys []string) []string {
+	seen := make(map[string]bool)
+	var out []string
+	for _, key := range keys {
+		if !seen[key] {
+			seen[key] = true
+			out = append(out, key)
+		}
+	}
+	return out
+}
+
+// Serve runs a request to method on keys, with run running it on the local
+// store. Requests are forwarded to a replica of the keys, writes to the
+// first one which answers. The replica reads the versions of R replicas before running the
+// request, and if write is set, sends the new versions to the other
+// replicas and returns once W of them have it.
+func (n *Node) Serve(ctx context.Context, method string, req interface{}, keys []string, write bool, run func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	keys = dedupe(keys)
+	replicas, err := n.preference(keys)
+	if err != nil {
+		return nil, err
+	}
+	w, r, err := n.quorum(ctx)
+	if err != nil {
+		return nil, err
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	// Writes go to the first replica which answers, so that writes to a
+	// key rarely conflict. Reads are served by any replica.
+	if len(md.Get(coordinateKey)) == 0 && (write || !contains(replicas, n.cfg.ID)) {
+		if resp, forwarded, err := n.forward(ctx, replicas, method, req); forwarded {
+			return resp, err
+		}
+	}
+
+	if err := n.read(keys, replicas, r); err != nil {
+		return nil, err
+	}
+	if !write {
+		return run(ctx)
+	}
+
+	n.mu.Lock()
+	resp, err := run(ctx)
+	if err != nil {
+		n.mu.Unlock()
+		return nil, err
+	}
+	versions, err := n.bump(keys)
+	n.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if err := n.replicate(keys[0], replicas, versions, w); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// forward sends a request to the first replica which answers, unless this
+// node comes first, and reports whether it did.
+func (n *Node) forward(ctx context.Context, replicas []string, method string, req interface{}) (interface{}, bool, error) {
+	resp, err := cluster.NewResponse(method)
+	if err != nil {
+		return nil, true, err
+	}
+	out := metadata.AppendToOutgoingContext(ctx, coordinateKey, "1")
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, key := range []string{writeQuorumKey, readQuorumKey} {
+		if values := md.Get(key); len(values) > 0 {
+			out = metadata.AppendToOutgoingContext(out, key, values[0])
+		}
+	}
+
+	for _, id := range replicas {
+		if id == n.cfg.ID {
+			return nil, false, nil
+		}
+		err = n.cfg.Transport.Forward(out, n.addresses[id], method, req, resp)
+		st, _ := status.FromError(err)
+		if err == nil || st.Code() != codes.Unavailable || strings.HasPrefix(st.Message(), "NOQUORUM") {
+			break
+		}
+	}
+	if err != nil {
+		return nil, true, err
+	}
+	return resp, true, nil
+}
+
+// versions returns the local versions of keys.
+func (n *Node) versions(keys []string) ([]*pb.DynamoVersion, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	entries, err := n.cfg.Store.Entries(keys)
+	if err != nil {
+		return nil, err
+	}
+	byKey := make(map[string]*pb.KeyEntry)
+	for _, e := range entries {
+		byKey[e.Key] = e
+	}
+	versions := make([]*pb.DynamoVersion, len(keys))
+	for i, key := range keys {
+		versions[i] = &pb.DynamoVersion{Key: key, Entry: byKey[key], Clock: n.clocks[key]}
+	}
+	return versions, nil
+}
+
+// apply stores the versions newer than the local ones and returns their
+// number.
+func (n *Node) apply(versions []*pb.DynamoVersion) (int, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	changes := &pb.CacheChanges{}
+	applied := 0
+	for _, v := range versions {
+		if !newer(v.Clock, n.clocks[v.Key]) {
+			continue
+		}
+		n.clocks[v.Key] = v.Clock
+		if v.Entry != nil {
+			changes.Entries = append(changes.Entries, v.Entry)
+		} else {
+			changes.Deleted = append(changes.Deleted, v.Key)
+		}
+		applied++
+	}
+	if applied == 0 {
+		return 0, nil
+	}
+	return applied, n.cfg.Store.ApplyChanges(changes)
+}
+
+// bump gives keys new versions written by this node, as changed by the
+// request just run. Must be called with n.mu held.
+func (n *Node) bump(keys []string) ([]*pb.DynamoVersion, error) {
+	entries, err := n.cfg.Store.Entries(keys)
+	if err != nil {
+		return nil, err
+	}
+	byKey := make(map[string]*pb.KeyEntry)
+	for _, e := range entries {
+		byKey[e.Key] = e
+	}
+
+	now := time.Now().UnixNano()
+	versions := make([]*pb.DynamoVersion, len(keys))
+	for i, key := range keys {
+		prev := n.clocks[key]
+		clock := merge(prev, nil)
+		clock.Counters[n.cfg.ID]++
+		clock.Timestamp, clock.Node = now, n.cfg.ID
+		if prev != nil && clock.Timestamp <= prev.Timestamp {
+			clock.Timestamp = prev.Timestamp + 1
+		}
+		n.clocks[key] = clock
+		versions[i] = &pb.DynamoVersion{Key: key, Entry: byKey[key], Clock: clock}
+	}
+	return versions, nil
+}
+
+type fetched struct {
+	id       string
+	versions []*pb.DynamoVersion
+}
+
+// read brings the local versions of keys up to date with the versions of
+// r replicas, this one included, and repairs the replicas which answered
+// with older versions.
+func (n *Node) read(keys []string, replicas []string, r int) error {
+	local, err := n.versions(keys)
+	if err != nil {
+		return err
+	}
+	others := n.others(replicas)
+	if r <= 1 || len(others) == 0 {
+		return nil
+	}
+
+	results := make(chan fetched, len(others))
+	req := &pb.DynamoKeys{Keys: keys}
+	for _, id := range others {
+		go func(id string) {
+			ctx, cancel := context.WithTimeout(context.Background(), n.cfg.Timeout)
+			defer cancel()
+			resp, err := n.cfg.Transport.Fetch(ctx, n.addresses[id], req)
+			if err != nil {
+				results <- fetched{id: id}
+				return
+			}
+			results <- fetched{id: id, versions: resp.Versions}
+		}(id)
+	}
+	answered := []fetched{{id: n.cfg.ID, versions: local}}
+	for i := 0; i < len(others) && len(answered) < r; i++ {
+		if res := <-results; res.versions != nil {
+			answered = append(answered, res)
+		}
+	}
+	if len(answered) < r {
+		return noQuorum("answered", len(answered), r)
+	}
+
+	winners := make(map[string]*pb.DynamoVersion)
+	for _, res := range answered {
+		for _, v := range res.versions {
+			if w, ok := winners[v.Key]; !ok || newer(v.Clock, w.Clock) {
+				winners[v.Key] = v
+			}
+		}
+	}
+	// The repaired versions descend every version seen, so that replicas
+	// stop seeing them as concurrent.
+	for _, res := range answered {
+		for _, v := range res.versions {
+			w := winners[v.Key]
+			if v.Clock != nil && !descends(w.Clock, v.Clock) {
+				winners[v.Key] = &pb.DynamoVersion{Key: w.Key, Entry: w.Entry, Clock: merge(w.Clock, v.Clock)}
+			}
+		}
+	}
+
+	if _, err := n.apply(sortedVersions(winners)); err != nil {
+		return err
+	}
+	for _, res := range answered[1:] {
+		var stale []*pb.DynamoVersion
+		for _, v := range res.versions {
+			if w := winners[v.Key]; !equal(w.Clock, v.Clock) {
+				stale = append(stale, w)
+			}
+		}
+		if len(stale) > 0 {
+			go n.store(res.id, "", stale)
+		}
+	}
+	return nil
+}
+
+func sortedVersions(m map[string]*pb.DynamoVersion) []*pb.DynamoVersion {
+	versions := make([]*pb.DynamoVersion, 0, len(m))
+	for _, v := range m {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Key < versions[j].Key
+	})
+	return versions
+}
+
+// store sends versions to node id, or as a hint to fallback if id can not
+// be reached, and reports whether either has them.
+func (n *Node) store(id, fallback string, versions []*pb.DynamoVersion) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), n.cfg.Timeout)
+	defer cancel()
+	if _, err := n.cfg.Transport.Store(ctx, n.addresses[id], &pb.DynamoStoreRequest{Versions: versions}); err == nil {
+		return true
+	}
+	if fallback == "" {
+		return false
+	}
+	ctx, cancel = context.WithTimeout(context.Background(), n.cfg.Timeout)
+	defer cancel()
+	req := &pb.DynamoStoreRequest{Versions: versions, Hint: id}
+	if fallback == n.cfg.ID {
+		n.DynamoStore(ctx, req)
+		return true
+	}
+	_, err := n.cfg.Transport.Store(ctx, n.addresses[fallback], req)
+	return err == nil
+}
+
+// replicate sends versions to the other replicas and returns once w
+// replicas, this one included, have them. The others keep receiving them
+// in the background.
+func (n *Node) replicate(key string, replicas []string, versions []*pb.DynamoVersion, w int) error {
+	others := n.others(replicas)
+	fallbacks := n.fallbacks(key)
+	acks := make(chan bool, len(others))
+	for i, id := range others {
+		var fallback string
+		if i < len(fallbacks) {
+			fallback = fallbacks[i]
+		}
+		go func(id, fallback string) {
+			acks <- n.store(id, fallback, versions)
+		}(id, fallback)
+	}
+
+	acked := 1
+	for i := 0; i < len(others) && acked < w; i++ {
+		if <-acks {
+			acked++
+		}
+	}
+	if acked < w {
+		return noQuorum("acknowledged", acked, w)
+	}
+	return nil
+}
+
+// handoff sends the hints to the nodes they are meant for.
+func (n *Node) handoff() {
+	n.mu.Lock()
+	pending := make(map[string][]*pb.DynamoVersion)
+	for id, hints := range n.hints {
+		for _, v := range hints {
+			pending[id] = append(pending[id], v)
+		}
+	}
+	n.mu.Unlock()
+
+	for id, versions := range pending {
+		ctx, cancel := context.WithTimeout(context.Background(), n.cfg.Timeout)
+		_, err := n.cfg.Transport.Store(ctx, n.addresses[id], &pb.DynamoStoreRequest{Versions: versions})
+		cancel()
+		if err != nil {
+			continue
+		}
+		n.mu.Lock()
+		for _, v := range versions {
+			// Hints received meanwhile are handed off next time.
+			if n.hints[id][v.Key] == v {
+				delete(n.hints[id], v.Key)
+			}
+		}
+		if len(n.hints[id]) == 0 {
+			delete(n.hints, id)
+		}
+		n.mu.Unlock()
+	}
+}
+
+// forgetTombstones drops the clocks of keys deleted or expired long ago.
+func (n *Node) forgetTombstones() {
+	limit := time.Now().Add(-tombstoneTTL).UnixNano()
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	for key, clock := range n.clocks {
+		if clock.Timestamp < limit && !n.cfg.Store.Exists(key) {
+			delete(n.clocks, key)
+		}
+	}
+}
+
+// DynamoFetch returns the local versions of keys.
+func (n *Node) DynamoFetch(ctx context.Context, req *pb.DynamoKeys) (*pb.DynamoVersions, error) {
+	versions, err := n.versions(req.Keys)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DynamoVersions{Versions: versions}, nil
+}
+
+// DynamoStore stores the versions newer than the local ones, or keeps them
+// as hints for the node req.Hint.
+func (n *Node) DynamoStore(ctx context.Context, req *pb.DynamoStoreRequest) (*pb.Count, error) {
+	if req.Hint == "" || req.Hint == n.cfg.ID {
+		applied, err := n.apply(req.Versions)
+		return &pb.Count{Count: int64(applied)}, err
+	}
+	if _, ok := n.addresses[req.Hint]; !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNoMember, req.Hint)
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	hints, ok := n.hints[req.Hint]
+	if !ok {
+		hints = make(map[string]*pb.DynamoVersion)
+		n.hints[req.Hint] = hints
+	}
+	for _, v := range req.Versions {
+		if cur, ok := hints[v.Key]; !ok || newer(v.Clock, cur.Clock) {
+			hints[v.Key] = v
+		}
+	}
+	return &pb.Count{Count: int64(len(req.Versions))}, nil
+}
+
+func (n *Node) State() *pb.DynamoState {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	st := &pb.DynamoState{
+		Id:          n.cfg.ID,
+		Replicas:    int32(n.cfg.Replicas),
+		WriteQuorum: int32(n.cfg.WriteQuorum),
+		ReadQuorum:  int32(n.cfg.ReadQuorum),
+	}
+	for _, m := range n.cfg.Members {
+		st.Members = append(st.Members, &pb.DynamoMember{Id: m.Id, Address: m.Address})
+	}
+	for _, hints := range n.hints {
+		st.Hints += int64(len(hints))
+	}
+	st.AntiEntropy = proto.Clone(n.stats).(*pb.AntiEntropyStats)
+	return st
+}
+
+func (n *Node) DynamoStatus(ctx context.Context, _ *empty.Empty) (*pb.DynamoState, error) {
+	return n.State(), nil
+}
@@ -0,0 +1,262 @@
+package dynamo
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// downTransport is a directTransport to which nodes can be unreachable.
+type downTransport struct {
+	directTransport
+	mu   sync.Mutex
+	down map[string]bool
+}
+
+func (t *downTransport) setDown(address string, down bool) {
+	t.mu.Lock()
+	t.down[address] = down
+	t.mu.Unlock()
+}
+
+func (t *downTransport) reach(address string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.down[address] {
+		return status.Errorf(codes.Unavailable, "%s is down", address)
+	}
+	return nil
+}
+
+func (t *downTransport) Fetch(ctx context.Context, address string, req *pb.DynamoKeys) (*pb.DynamoVersions, error) {
+	if err := t.reach(address); err != nil {
+		return nil, err
+	}
+	return t.directTransport.Fetch(ctx, address, req)
+}
+
+func (t *downTransport) Store(ctx context.Context, address string, req *pb.DynamoStoreRequest) (*pb.Count, error) {
+	if err := t.reach(address); err != nil {
+		return nil, err
+	}
+	return t.directTransport.Store(ctx, address, req)
+}
+
+// group is a ring of three nodes keeping each key twice, with quorums of
+// two.
+type group struct {
+	transport *downTransport
+	nodes     map[string]*Node
+	stores    map[string]*mapStore
+}
+
+func newGroup(t *testing.T) *group {
+	t.Helper()
+	r := &group{
+		transport: &downTransport{directTransport: directTransport{}, down: make(map[string]bool)},
+		nodes:     make(map[string]*Node),
+		stores:    make(map[string]*mapStore),
+	}
+	members := []*pb.DynamoMember{{Id: "a", Address: "a"}, {Id: "b", Address: "b"}, {Id: "c", Address: "c"}}
+	for _, m := range members {
+		store := newMapStore()
+		n, err := New(Config{
+			ID:                  m.Id,
+			Address:             m.Address,
+			Members:             members,
+			Replicas:            2,
+			Timeout:             100 * time.Millisecond,
+			AntiEntropyInterval: -1,
+			Store:               store,
+			Transport:           r.transport,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		r.transport.directTransport[m.Address] = n
+		r.nodes[m.Id] = n
+		r.stores[m.Id] = store
+	}
+	return r
+}
+
+// layout returns the replicas of key, in preference order, and the node
+// keeping its hints.
+func (r *group) layout(key string) (string, string, string) {
+	n := r.nodes["a"]
+	replicas := n.ring.Nodes(key, n.cfg.Replicas)
+	return replicas[0], replicas[1], n.fallbacks(key)[0]
+}
+
+// coordinate returns the context of a request coordinated by the node it
+// is sent to, with the given metadata.
+func coordinate(kv ...string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(append([]string{coordinateKey, "1"}, kv...)...))
+}
+
+// set writes value at key through the coordinator id.
+func (r *group) set(ctx context.Context, id, key, value string) error {
+	store := r.stores[id]
+	_, err := r.nodes[id].Serve(ctx, "/CacheService/Set", &pb.String{Key: key, Value: value}, []string{key}, true, func(ctx context.Context) (interface{}, error) {
+		return nil, store.ApplyChanges(&pb.CacheChanges{Entries: []*pb.KeyEntry{{Key: key, Value: []byte(value)}}})
+	})
+	return err
+}
+
+// get reads the value at key through the coordinator id.
+func (r *group) get(ctx context.Context, id, key string) (string, error) {
+	store := r.stores[id]
+	resp, err := r.nodes[id].Serve(ctx, "/CacheService/Get", &pb.Key{Key: key}, []string{key}, false, func(ctx context.Context) (interface{}, error) {
+		return store.value(key), nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.(string), nil
+}
+
+func (s *mapStore) value(key string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if e, ok := s.entries[key]; ok {
+		return string(e.Value)
+	}
+	return ""
+}
+
+func isNoQuorum(err error) bool {
+	st, _ := status.FromError(err)
+	return st.Code() == codes.Unavailable && strings.HasPrefix(st.Message(), "NOQUORUM")
+}
+
+func TestQuorumFailure(t *testing.T) {
+	r := newGroup(t)
+	coord, replica, fallback := r.layout("key")
+	if err := r.set(coordinate(), coord, "key", "v1"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reads only count replicas, so one replica down fails R = 2.
+	r.transport.setDown(replica, true)
+	if _, err := r.get(coordinate(), coord, "key"); !isNoQuorum(err) {
+		t.Errorf("read with a replica down failed with %v, want NOQUORUM", err)
+	}
+	if v, err := r.get(coordinate(readQuorumKey, "1"), coord, "key"); err != nil || v != "v1" {
+		t.Errorf("read with R = 1 returned %q, %v", v, err)
+	}
+
+	// Writes read R replicas first, and then count hints, so they need
+	// the fallback once R allows the replica to be down.
+	if err := r.set(coordinate(), coord, "key", "v2"); !isNoQuorum(err) {
+		t.Errorf("write with a replica down failed with %v, want NOQUORUM", err)
+	}
+	r.transport.setDown(fallback, true)
+	err := r.set(coordinate(readQuorumKey, "1"), coord, "key", "v3")
+	if !isNoQuorum(err) || !strings.Contains(err.Error(), "acknowledged") {
+		t.Errorf("write with a replica and its fallback down failed with %v, want NOQUORUM", err)
+	}
+	if err := r.set(coordinate(readQuorumKey, "1", writeQuorumKey, "1"), coord, "key", "v4"); err != nil {
+		t.Errorf("write with W = 1: %v", err)
+	}
+}
+
+func TestHintedHandoff(t *testing.T) {
+	r := newGroup(t)
+	coord, replica, fallback := r.layout("key")
+	r.transport.setDown(replica, true)
+	if err := r.set(coordinate(readQuorumKey, "1"), coord, "key", "v"); err != nil {
+		t.Fatalf("write with the fallback keeping a hint: %v", err)
+	}
+	if hints := r.nodes[fallback].State().Hints; hints != 1 {
+		t.Fatalf("fallback keeps %d hints, want 1", hints)
+	}
+	if v := r.stores[fallback].value("key"); v != "" {
+		t.Errorf("fallback stored the hint as its own value %q", v)
+	}
+
+	// Hints are kept while the replica is down.
+	r.nodes[fallback].handoff()
+	if hints := r.nodes[fallback].State().Hints; hints != 1 {
+		t.Fatalf("fallback keeps %d hints after a failed handoff, want 1", hints)
+	}
+
+	r.transport.setDown(replica, false)
+	r.nodes[fallback].handoff()
+	if v := r.stores[replica].value("key"); v != "v" {
+		t.Errorf("replica holds %q after handoff, want v", v)
+	}
+	if hints := r.nodes[fallback].State().Hints; hints != 0 {
+		t.Errorf("fallback keeps %d hints after handoff, want 0", hints)
+	}
+}
+
+func TestReadRepair(t *testing.T) {
+	r := newGroup(t)
+	coord, replica, _ := r.layout("key")
+
+	// The replica missed a write.
+	write(t, r.nodes[coord], "key", "x")
+	if v, err := r.get(coordinate(), coord, "key"); err != nil || v != "x" {
+		t.Fatalf("read returned %q, %v", v, err)
+	}
+	waitRepaired(t, r, "key", coord, replica)
+
+	// The coordinator missed a write: it is repaired before the request
+	// runs.
+	write(t, r.nodes[replica], "stale", "x")
+	if v, err := r.get(coordinate(), coord, "stale"); err != nil || v != "x" {
+		t.Fatalf("stale coordinator read %q, %v, want x", v, err)
+	}
+
+	// Concurrent versions resolve by last writer, here by writer as the
+	// timestamps are equal, and the repaired version descends both.
+	write(t, r.nodes[coord], "concurrent", "x")
+	write(t, r.nodes[replica], "concurrent", "y")
+	if v, err := r.get(coordinate(), coord, "concurrent"); err != nil || v != "y" {
+		t.Fatalf("read of concurrent versions returned %q, %v, want y", v, err)
+	}
+	waitRepaired(t, r, "concurrent", coord, replica)
+	versions, err := r.nodes[replica].versions([]string{"concurrent"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if clock := versions[0].Clock; clock.Counters["x"] != 1 || clock.Counters["y"] != 1 {
+		t.Errorf("repaired clock %v does not descend both versions", clock)
+	}
+}
+
+// waitRepaired waits for the replicas ids of key to hold the same version.
+func waitRepaired(t *testing.T, r *group, key string, ids ...string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		want, err := r.nodes[ids[0]].versions([]string{key})
+		if err != nil {
+			t.Fatal(err)
+		}
+		repaired := true
+		for _, id := range ids[1:] {
+			got, err := r.nodes[id].versions([]string{key})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equal(got[0].Clock, want[0].Clock) || r.stores[id].value(key) != r.stores[ids[0]].value(key) {
+				repaired = false
+			}
+		}
+		if repaired {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("%s not repaired on %v", key, ids)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -0,0 +1,73 @@
+package dynamo
+
+import (
+	"crypto/md5"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/shanukun/cash/cluster"
+)
+
+// virtualNodes is the number of points of each node on the ring, which
+// spreads keys evenly across nodes.
+const virtualNodes = 128
+
+type point struct {
+	hash uint64
+	id   string
+}
+
+// Ring is a consistent hash ring. A key belongs to the first nodes met
+// going clockwise from its hash, so that adding or removing a node only
+// moves the keys next to its points.
+type Ring struct {
+	points []point
+}
+
+// hash places s on the ring. MD5 spreads similar strings, such as the
+// points of a node, much better than faster hashes.
+func hash(s string) uint64 {
+	sum := md5.Sum([]byte(s))
+	return binary.BigEndian.Uint64(sum[:8])
+}
+
+// NewRing returns the ring of nodes ids.
+func NewRing(ids []string) *Ring {
+	r := &Ring{}
+	for _, id := range ids {
+		for i := 0; i < virtualNodes; i++ {
+			r.points = append(r.points, point{hash: hash(fmt.Sprintf("%s#%d", id, i)), id: id})
+		}
+	}
+	sort.Slice(r.points, func(i, j int) bool {
+		if r.points[i].hash != r.points[j].hash {
+			return r.points[i].hash < r.points[j].hash
+		}
+		return r.points[i].id < r.points[j].id
+	})
+	return r
+}
+
+// Nodes returns up to n distinct nodes in ring order from key. Keys sharing
+// a hash tag, such as {user:1}:name and {user:1}:email, get the same nodes.
+func (r *Ring) Nodes(key string, n int) []string {
+	if len(r.points) == 0 {
+		return nil
+	}
+	h := hash(cluster.HashTag(key))
+	start := sort.Search(len(r.points), func(i int) bool {
+		return r.points[i].hash >= h
+	})
+
+	var ids []string
+	seen := make(map[string]bool)
+	for i := 0; i < len(r.points) && len(ids) < n; i++ {
+		p := r.points[(start+i)%len(r.points)]
+		if !seen[p.id] {
+			seen[p.id] = true
+			ids = append(ids, p.id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,86 @@
+package dynamo
+
+import (
+	"context"
+	"sync"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Transport sends requests to other nodes, given their address.
+type Transport interface {
+	Fetch(ctx context.Context, address string, req *pb.DynamoKeys) (*pb.DynamoVersions, error)
+	Store(ctx context.Context, address string, req *pb.DynamoStoreRequest) (*pb.Count, error)
+	RangeHashes(ctx context.Context, address string, req *pb.DynamoRangeRequest) (*pb.RangeHashes, error)
+	RangeDigests(ctx context.Context, address string, req *pb.DynamoRangeRequest) (*pb.DynamoVersions, error)
+	// Forward sends a request to method of another node, such as
+	// /CacheService/Get, and decodes the response into resp.
+	Forward(ctx context.Context, address, method string, req, resp interface{}) error
+}
+
+// GRPCTransport sends requests to the DynamoService and CacheService of
+// other nodes, keeping one connection per address.
+type GRPCTransport struct {
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+func NewGRPCTransport() *GRPCTransport {
+	return &GRPCTransport{conns: make(map[string]*grpc.ClientConn)}
+}
+
+func (t *GRPCTransport) conn(address string) (*grpc.ClientConn, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if conn, ok := t.conns[address]; ok {
+		return conn, nil
+	}
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	t.conns[address] = conn
+	return conn, nil
+}
+
+func (t *GRPCTransport) Fetch(ctx context.Context, address string, req *pb.DynamoKeys) (*pb.DynamoVersions, error) {
+	conn, err := t.conn(address)
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewDynamoServiceClient(conn).DynamoFetch(ctx, req)
+}
+
+func (t *GRPCTransport) Store(ctx context.Context, address string, req *pb.DynamoStoreRequest) (*pb.Count, error) {
+	conn, err := t.conn(address)
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewDynamoServiceClient(conn).DynamoStore(ctx, req)
+}
+
+func (t *GRPCTransport) RangeHashes(ctx context.Context, address string, req *pb.DynamoRangeRequest) (*pb.RangeHashes, error) {
+	conn, err := t.conn(address)
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewDynamoServiceClient(conn).DynamoRangeHashes(ctx, req)
+}
+
+func (t *GRPCTransport) RangeDigests(ctx context.Context, address string, req *pb.DynamoRangeRequest) (*pb.DynamoVersions, error) {
+	conn, err := t.conn(address)
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewDynamoServiceClient(conn).DynamoRangeDigests(ctx, req)
+}
+
+func (t *GRPCTransport) Forward(ctx context.Context, address, method string, req, resp interface{}) error {
+	conn, err := t.conn(address)
+	if err != nil {
+		return err
+	}
+	return conn.Invoke(ctx, method, req, resp)
+}
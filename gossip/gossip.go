@@ -0,0 +1,598 @@
+// Package gossip keeps track of the nodes of a deployment with the SWIM
+// protocol. Nodes join through seeds, probe each other to detect failures
+// and piggyback membership updates on their probes, so that every node
+// learns about changes in a number of rounds logarithmic in the number of
+// nodes.
+package gossip
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/shanukun/cash/cash_proto"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrWrongTarget = errors.New("Ping was meant for another node")
+	ErrUnreachable = errors.New("Node is unreachable")
+)
+
+const (
+	defaultProbeInterval  = time.Second
+	defaultProbeTimeout   = 500 * time.Millisecond
+	defaultIndirectProbes = 3
+	defaultSuspicionMult  = 4
+	defaultRetransmitMult = 4
+	defaultSyncInterval   = 30 * time.Second
+	defaultReapTimeout    = 5 * time.Minute
+
+	maxPiggyback = 16
+)
+
+type Config struct {
+	ID      string
+	Address string
+	Role    string
+	// Seeds are the addresses of nodes to join through.
+	Seeds []string
+
+	// ProbeInterval is the time between two probes of the node, each
+	// probing one other member.
+	ProbeInterval time.Duration
+	// ProbeTimeout is the time to wait for a direct probe to be acked
+	// before asking IndirectProbes other members to probe the target.
+	ProbeTimeout   time.Duration
+	IndirectProbes int
+	// SuspicionMult scales the time a suspected member has to refute the
+	// suspicion before it is declared dead, in probe intervals and with
+	// the logarithm of the number of members.
+	SuspicionMult int
+	// RetransmitMult scales the number of times an update is piggybacked,
+	// with the logarithm of the number of members.
+	RetransmitMult int
+	// SyncInterval is the time between two exchanges of the full
+	// membership with a random member, which repairs what gossip missed.
+	SyncInterval time.Duration
+	// ReapTimeout is the time dead and departed members are remembered.
+	ReapTimeout time.Duration
+
+	Transport Transport
+	// Notify is called with every member whose state or metadata changed.
+	Notify func(m *pb.GossipMember)
+}
+
+type member struct {
+	*pb.GossipMember
+	// since is when the member got its current state.
+	since time.Time
+}
+
+type broadcast struct {
+	update    *pb.GossipMember
+	transmits int
+}
+
+// Node is the view of the membership held by a node.
+type Node struct {
+	pb.UnimplementedGossipServiceServer
+
+	mu      sync.Mutex
+	cfg     Config
+	self    *pb.GossipMember
+	members map[string]*member
+	queue   map[string]*broadcast
+	// order is the shuffled list of members probed in turn.
+	order   []string
+	stopped chan struct{}
+}
+
+// New returns the node cfg.ID, alone in its membership until started.
+func New(cfg Config) *Node {
+	if cfg.ProbeInterval == 0 {
+		cfg.ProbeInterval = defaultProbeInterval
+	}
+	if cfg.ProbeTimeout == 0 {
+		cfg.ProbeTimeout = defaultProbeTimeout
+	}
+	if cfg.IndirectProbes == 0 {
+		cfg.IndirectProbes = defaultIndirectProbes
+	}
+	if cfg.SuspicionMult == 0 {
+		cfg.SuspicionMult = defaultSuspicionMult
+	}
+	if cfg.RetransmitMult == 0 {
+		cfg.RetransmitMult = defaultRetransmitMult
+	}
+	if cfg.SyncInterval == 0 {
+		cfg.SyncInterval = defaultSyncInterval
+	}
+	if cfg.ReapTimeout == 0 {
+		cfg.ReapTimeout = defaultReapTimeout
+	}
+	if cfg.Transport == nil {
+		cfg.Transport = NewGRPCTransport()
+	}
+
+	self := &pb.GossipMember{
+		Id:      cfg.ID,
+		Address: cfg.Address,
+		State:   pb.MemberState_MEMBER_ALIVE,
+		Role:    cfg.Role,
+	}
+	return &Node{
+		cfg:  cfg,
+		self: self,
+		members: map[string]*member{
+			cfg.ID: {GossipMember: self, since: time.Now()},
+		},
+		queue:   make(map[string]*broadcast),
+		stopped: make(chan struct{}),
+	}
+}
+
+// Start joins the seeds and starts probing. Seeds which can not be reached
+// are tried again while the node knows no other live member.
+func (n *Node) Start() {
+	go n.run()
+}
+
+func (n *Node) Stop() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	select {
+	case <-n.stopped:
+	default:
+		close(n.stopped)
+	}
+}
+
+// Leave tells other members that the node leaves on purpose, so that they
+// do not suspect it, and stops it.
+func (n *Node) Leave(ctx context.Context) {
+	n.mu.Lock()
+	n.self.Incarnation++
+	n.self.State = pb.MemberState_MEMBER_LEFT
+	n.enqueue(n.self)
+	req := &pb.GossipSync{Members: n.snapshot()}
+	targets := n.randomMembers(n.cfg.IndirectProbes, "")
+	n.mu.Unlock()
+
+	var wg sync.WaitGroup
+	for _, m := range targets {
+		wg.Add(1)
+		go func(address string) {
+			defer wg.Done()
+			n.cfg.Transport.Sync(ctx, address, req)
+		}(m.Address)
+	}
+	wg.Wait()
+	n.Stop()
+}
+
+func clone(m *pb.GossipMember) *pb.GossipMember {
+	return proto.Clone(m).(*pb.GossipMember)
+}
+
+// snapshot returns a copy of every member, sorted by id.
+func (n *Node) snapshot() []*pb.GossipMember {
+	members := make([]*pb.GossipMember, 0, len(n.members))
+	for _, m := range n.members {
+		members = append(members, clone(m.GossipMember))
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Id < members[j].Id
+	})
+	return members
+}
+
+// Members returns every known member, dead ones included, sorted by id.
+func (n *Node) Members() []*pb.GossipMember {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.snapshot()
+}
+
+// Self returns the local member.
+func (n *Node) Self() *pb.GossipMember {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return clone(n.self)
+}
+
+// SetMeta changes the metadata advertised by the node. Other members learn
+// about it through gossip.
+func (n *Node) SetMeta(role string, slots []*pb.SlotRange, meta map[string]string) {
+	n.mu.Lock()
+	update := clone(n.self)
+	update.Role = role
+	update.Slots = slots
+	update.Meta = meta
+	if proto.Equal(update, n.self) {
+		n.mu.Unlock()
+		return
+	}
+	n.self.Role = role
+	n.self.Slots = update.Slots
+	n.self.Meta = update.Meta
+	n.self.Incarnation++
+	n.enqueue(n.self)
+	changed := clone(n.self)
+	n.mu.Unlock()
+	n.notify([]*pb.GossipMember{changed})
+}
+
+func (n *Node) notify(changed []*pb.GossipMember) {
+	if n.cfg.Notify == nil {
+		return
+	}
+	for _, m := range changed {
+		n.cfg.Notify(m)
+	}
+}
+
+// live returns the number of members which are alive or suspected.
+func (n *Node) live() int {
+	count := 0
+	for _, m := range n.members {
+		if m.State == pb.MemberState_MEMBER_ALIVE || m.State == pb.MemberState_MEMBER_SUSPECT {
+			count++
+		}
+	}
+	return count
+}
+
+// scale returns mult times the logarithm of the number of live members,
+// at least mult.
+func (n *Node) scale(mult int) int {
+	return mult * int(math.Max(1, math.Ceil(math.Log10(float64(n.live()+1)))))
+}
+
+// enqueue queues an update for dissemination, replacing older updates of
+// the same member. Must be called with n.mu held.
+func (n *Node) enqueue(m *pb.GossipMember) {
+	n.queue[m.Id] = &broadcast{update: clone(m)}
+}
+
+// piggyback returns the updates to send along with a message, least sent
+// first, and forgets those sent often enough.
+func (n *Node) piggyback() []*pb.GossipMember {
+	broadcasts := make([]*broadcast, 0, len(n.queue))
+	for _, b := range n.queue {
+		broadcasts = append(broadcasts, b)
+	}
+	sort.Slice(broadcasts, func(i, j int) bool {
+		return broadcasts[i].transmits < broadcasts[j].transmits
+	})
+	if len(broadcasts) > maxPiggyback {
+		broadcasts = broadcasts[:maxPiggyback]
+	}
+
+	limit := n.scale(n.cfg.RetransmitMult)
+	updates := make([]*pb.GossipMember, 0, len(broadcasts))
+	for _, b := range broadcasts {
+		updates = append(updates, clone(b.update))
+		b.transmits++
+		if b.transmits >= limit {
+			delete(n.queue, b.update.Id)
+		}
+	}
+	return updates
+}
+
+// supersedes reports whether update u overrides what is known of m. An
+// alive member only overrides older incarnations, while suspicions and
+// deaths override the same incarnation.
+func supersedes(u *pb.GossipMember, m *pb.GossipMember) bool {
+	if u.Incarnation != m.Incarnation {
+		return u.Incarnation > m.Incarnation
+	}
+	switch u.State {
+	case pb.MemberState_MEMBER_SUSPECT:
+		return m.State == pb.MemberState_MEMBER_ALIVE
+	case pb.MemberState_MEMBER_DEAD, pb.MemberState_MEMBER_LEFT:
+		return m.State == pb.MemberState_MEMBER_ALIVE || m.State == pb.MemberState_MEMBER_SUSPECT
+	}
+	return false
+}
+
+// merge applies updates received from another member and returns the
+// members which changed. Deaths in a full membership are only taken as
+// suspicions, as a node back from a partition holds many stale ones. Must
+// be called with n.mu held.
+func (n *Node) merge(updates []*pb.GossipMember, full bool) []*pb.GossipMember {
+	var changed []*pb.GossipMember
+	for _, u := range updates {
+		if full && u.State == pb.MemberState_MEMBER_DEAD {
+			u = clone(u)
+			u.State = pb.MemberState_MEMBER_SUSPECT
+		}
+		if u.Id == n.self.Id {
+			// A node refutes suspicions of itself, and claims of its
+			// death after a restart, with a newer incarnation.
+			if n.self.State == pb.MemberState_MEMBER_ALIVE && u.Incarnation >= n.self.Incarnation &&
+				(u.State != pb.MemberState_MEMBER_ALIVE || u.Incarnation > n.self.Incarnation) {
+				n.self.Incarnation = u.Incarnation + 1
+				n.enqueue(n.self)
+				changed = append(changed, clone(n.self))
+			}
+			continue
+		}
+
+		m, known := n.members[u.Id]
+		if !known {
+			// Departed members which were forgotten stay forgotten.
+			if u.State == pb.MemberState_MEMBER_DEAD || u.State == pb.MemberState_MEMBER_LEFT {
+				continue
+			}
+			n.members[u.Id] = &member{GossipMember: clone(u), since: time.Now()}
+			n.enqueue(u)
+			changed = append(changed, clone(u))
+			continue
+		}
+		if !supersedes(u, m.GossipMember) {
+			continue
+		}
+		if u.State != m.State {
+			m.since = time.Now()
+		}
+		m.GossipMember = clone(u)
+		n.enqueue(u)
+		changed = append(changed, clone(u))
+	}
+	return changed
+}
+
+// randomMembers returns up to k random live members other than the node
+// and the member except.
+func (n *Node) randomMembers(k int, except string) []*pb.GossipMember {
+	var candidates []*pb.GossipMember
+	for id, m := range n.members {
+		if id == n.self.Id || id == except || m.State != pb.MemberState_MEMBER_ALIVE {
+			continue
+		}
+		candidates = append(candidates, clone(m.GossipMember))
+	}
+	rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	if len(candidates) > k {
+		candidates = candidates[:k]
+	}
+	return candidates
+}
+
+// nextTarget returns the next member to probe. Members are probed in a
+// random order, each once per round.
+func (n *Node) nextTarget() *pb.GossipMember {
+	for attempts := 0; attempts < 2; attempts++ {
+		for len(n.order) > 0 {
+			id := n.order[0]
+			n.order = n.order[1:]
+			m, ok := n.members[id]
+			if ok && (m.State == pb.MemberState_MEMBER_ALIVE || m.State == pb.MemberState_MEMBER_SUSPECT) {
+				return clone(m.GossipMember)
+			}
+		}
+		for id := range n.members {
+			if id != n.self.Id {
+				n.order = append(n.order, id)
+			}
+		}
+		rand.Shuffle(len(n.order), func(i, j int) {
+			n.order[i], n.order[j] = n.order[j], n.order[i]
+		})
+	}
+	return nil
+}
+
+func (n *Node) run() {
+	n.sync()
+	probes := time.NewTicker(n.cfg.ProbeInterval)
+	defer probes.Stop()
+	syncs := time.NewTicker(n.cfg.SyncInterval)
+	defer syncs.Stop()
+	for {
+		select {
+		case <-n.stopped:
+			return
+		case <-probes.C:
+			n.expire()
+			n.probe()
+			n.mu.Lock()
+			alone := n.live() == 1
+			n.mu.Unlock()
+			if alone {
+				n.sync()
+			}
+		case <-syncs.C:
+			n.sync()
+		}
+	}
+}
+
+// expire declares dead the suspected members which did not refute the
+// suspicion in time, and forgets members departed long ago.
+func (n *Node) expire() {
+	n.mu.Lock()
+	now := time.Now()
+	timeout := time.Duration(n.scale(n.cfg.SuspicionMult)) * n.cfg.ProbeInterval
+	var changed []*pb.GossipMember
+	for id, m := range n.members {
+		switch m.State {
+		case pb.MemberState_MEMBER_SUSPECT:
+			if now.Sub(m.since) > timeout {
+				m.State = pb.MemberState_MEMBER_DEAD
+				m.since = now
+				n.enqueue(m.GossipMember)
+				changed = append(changed, clone(m.GossipMember))
+			}
+		case pb.MemberState_MEMBER_DEAD, pb.MemberState_MEMBER_LEFT:
+			if id != n.self.Id && now.Sub(m.since) > n.cfg.ReapTimeout {
+				delete(n.members, id)
+			}
+		}
+	}
+	n.mu.Unlock()
+	n.notify(changed)
+}
+
+// probe pings the next member, then asks other members to ping it if it
+// does not answer in time. A member answering neither way is suspected.
+func (n *Node) probe() {
+	n.mu.Lock()
+	target := n.nextTarget()
+	if target == nil {
+		n.mu.Unlock()
+		return
+	}
+	ping := &pb.GossipPing{From: n.self.Id, Target: target.Id, Updates: n.piggyback()}
+	n.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), n.cfg.ProbeTimeout)
+	ack, err := n.cfg.Transport.Ping(ctx, target.Address, ping)
+	cancel()
+	if err == nil {
+		n.receive(ack.Updates, false)
+		return
+	}
+
+	n.mu.Lock()
+	helpers := n.randomMembers(n.cfg.IndirectProbes, target.Id)
+	req := &pb.GossipPingReq{
+		From:          n.self.Id,
+		Target:        target.Id,
+		TargetAddress: target.Address,
+		Updates:       n.piggyback(),
+	}
+	n.mu.Unlock()
+
+	ctx, cancel = context.WithTimeout(context.Background(), n.cfg.ProbeInterval-n.cfg.ProbeTimeout)
+	defer cancel()
+	acks := make(chan bool, len(helpers))
+	for _, h := range helpers {
+		go func(address string) {
+			ack, err := n.cfg.Transport.PingReq(ctx, address, req)
+			if err != nil {
+				acks <- false
+				return
+			}
+			n.receive(ack.Updates, false)
+			acks <- ack.Acked
+		}(h.Address)
+	}
+	for range helpers {
+		if <-acks {
+			return
+		}
+	}
+	n.suspect(target)
+}
+
+func (n *Node) suspect(target *pb.GossipMember) {
+	n.mu.Lock()
+	m, ok := n.members[target.Id]
+	if !ok || m.Incarnation != target.Incarnation || m.State != pb.MemberState_MEMBER_ALIVE {
+		n.mu.Unlock()
+		return
+	}
+	m.State = pb.MemberState_MEMBER_SUSPECT
+	m.since = time.Now()
+	n.enqueue(m.GossipMember)
+	changed := clone(m.GossipMember)
+	n.mu.Unlock()
+	n.notify([]*pb.GossipMember{changed})
+}
+
+func (n *Node) receive(updates []*pb.GossipMember, full bool) {
+	n.mu.Lock()
+	changed := n.merge(updates, full)
+	n.mu.Unlock()
+	n.notify(changed)
+}
+
+// sync exchanges the full membership with a random live member, or with
+// the seeds while no other member is known to be alive.
+func (n *Node) sync() {
+	n.mu.Lock()
+	req := &pb.GossipSync{Members: n.snapshot()}
+	var addresses []string
+	for _, m := range n.randomMembers(1, "") {
+		addresses = append(addresses, m.Address)
+	}
+	if len(addresses) == 0 {
+		for _, seed := range n.cfg.Seeds {
+			if seed != n.self.Address {
+				addresses = append(addresses, seed)
+			}
+		}
+	}
+	n.mu.Unlock()
+
+	for _, address := range addresses {
+		ctx, cancel := context.WithTimeout(context.Background(), n.cfg.ProbeInterval)
+		resp, err := n.cfg.Transport.Sync(ctx, address, req)
+		cancel()
+		if err == nil {
+			n.receive(resp.Members, true)
+		}
+	}
+}
+
+func (n *Node) Ping(ctx context.Context, req *pb.GossipPing) (*pb.GossipAck, error) {
+	if req.Target != "" && req.Target != n.cfg.ID {
+		return nil, ErrWrongTarget
+	}
+	n.mu.Lock()
+	changed := n.merge(req.Updates, false)
+	ack := &pb.GossipAck{Acked: true, Updates: n.piggyback()}
+	n.mu.Unlock()
+	n.notify(changed)
+	return ack, nil
+}
+
+// PingReq pings a member on behalf of another whose ping went unanswered.
+func (n *Node) PingReq(ctx context.Context, req *pb.GossipPingReq) (*pb.GossipAck, error) {
+	n.mu.Lock()
+	changed := n.merge(req.Updates, false)
+	ping := &pb.GossipPing{From: n.self.Id, Target: req.Target, Updates: n.piggyback()}
+	n.mu.Unlock()
+	n.notify(changed)
+
+	ack := &pb.GossipAck{}
+	pctx, cancel := context.WithTimeout(ctx, n.cfg.ProbeTimeout)
+	resp, err := n.cfg.Transport.Ping(pctx, req.TargetAddress, ping)
+	cancel()
+	if err == nil {
+		n.receive(resp.Updates, false)
+		ack.Acked = true
+	}
+	n.mu.Lock()
+	ack.Updates = n.piggyback()
+	n.mu.Unlock()
+	return ack, nil
+}
+
+// Sync merges the full membership of another member and returns the
+// membership of the node.
+func (n *Node) Sync(ctx context.Context, req *pb.GossipSync) (*pb.GossipSync, error) {
+	n.mu.Lock()
+	changed := n.merge(req.Members, true)
+	resp := &pb.GossipSync{Members: n.snapshot()}
+	n.mu.Unlock()
+	n.notify(changed)
+	return resp, nil
+}
+
+func (n *Node) GossipMembers(ctx context.Context, _ *empty.Empty) (*pb.GossipState, error) {
+	return &pb.GossipState{
+		Self:    n.cfg.ID,
+		Members: n.Members(),
+	}, nil
+}
@@ -0,0 +1,217 @@
+package gossip
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/shanukun/cash/cash_proto"
+)
+
+// recorder records the states other members are seen in by a node.
+type recorder struct {
+	mu     sync.Mutex
+	states map[string][]pb.MemberState
+}
+
+func (r *recorder) notify(m *pb.GossipMember) {
+	r.mu.Lock()
+	r.states[m.Id] = append(r.states[m.Id], m.State)
+	r.mu.Unlock()
+}
+
+func (r *recorder) seen(id string) []pb.MemberState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]pb.MemberState(nil), r.states[id]...)
+}
+
+// newGroup starts n nodes joining through the first one over a
+// LocalTransport. wrap, if set, wraps the transport of each node.
+func newGroup(t *testing.T, n int, wrap func(address string, tr Transport) Transport) ([]*Node, []*recorder, *LocalTransport) {
+	t.Helper()
+	transport := NewLocalTransport()
+	var nodes []*Node
+	var recorders []*recorder
+	for i := 0; i < n; i++ {
+		address := fmt.Sprint("node", i)
+		tr := transport.From(address)
+		if wrap != nil {
+			tr = wrap(address, tr)
+		}
+		r := &recorder{states: make(map[string][]pb.MemberState)}
+		node := New(Config{
+			ID:            address,
+			Address:       address,
+			Seeds:         []string{"node0"},
+			ProbeInterval: 20 * time.Millisecond,
+			ProbeTimeout:  5 * time.Millisecond,
+			SuspicionMult: 1,
+			ReapTimeout:   200 * time.Millisecond,
+			Transport:     tr,
+			Notify:        r.notify,
+		})
+		transport.Register(address, node)
+		nodes = append(nodes, node)
+		recorders = append(recorders, r)
+	}
+	for _, node := range nodes {
+		node.Start()
+		t.Cleanup(node.Stop)
+	}
+	waitFor(t, "members to join", func() bool {
+		for _, node := range nodes {
+			if alive(node) != n {
+				return false
+			}
+		}
+		return true
+	})
+	return nodes, recorders, transport
+}
+
+// alive returns the number of members node holds alive.
+func alive(node *Node) int {
+	count := 0
+	for _, m := range node.Members() {
+		if m.State == pb.MemberState_MEMBER_ALIVE {
+			count++
+		}
+	}
+	return count
+}
+
+// view returns the member id as seen by node, or nil if it does not know
+// it.
+func view(node *Node, id string) *pb.GossipMember {
+	for _, m := range node.Members() {
+		if m.Id == id {
+			return m
+		}
+	}
+	return nil
+}
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestKilledNodeSuspectedThenDead(t *testing.T) {
+	nodes, recorders, transport := newGroup(t, 3, nil)
+	transport.SetDown("node2", true)
+
+	// A node may learn of the death from the other before suspecting
+	// node2 itself, but some node suspects it first.
+	suspected := false
+	for i, node := range nodes[:2] {
+		waitFor(t, "node2 to be declared dead", func() bool {
+			m := view(node, "node2")
+			return m != nil && m.State == pb.MemberState_MEMBER_DEAD
+		})
+		seen := recorders[i].seen("node2")
+		if len(seen) >= 2 && seen[len(seen)-2] == pb.MemberState_MEMBER_SUSPECT {
+			suspected = true
+		}
+	}
+	if !suspected {
+		t.Errorf("node2 was declared dead without being suspected")
+	}
+
+	// Dead members are forgotten after the reap timeout.
+	waitFor(t, "node2 to be reaped", func() bool {
+		return view(nodes[0], "node2") == nil
+	})
+}
+
+func TestSuspectedNodeRefutes(t *testing.T) {
+	nodes, _, _ := newGroup(t, 3, nil)
+	a, b := nodes[0], nodes[1]
+
+	// A suspicion of the live node b, as after a lost ping, reaches b,
+	// which refutes it with a newer incarnation that every node ends up
+	// holding.
+	a.suspect(view(a, b.cfg.ID))
+	waitFor(t, "b to refute the suspicion", func() bool {
+		inc := b.Self().Incarnation
+		if inc == 0 {
+			return false
+		}
+		for _, node := range nodes {
+			m := view(node, b.cfg.ID)
+			if m == nil || m.State != pb.MemberState_MEMBER_ALIVE || m.Incarnation != inc {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func TestFullSyncDeathIsSuspicion(t *testing.T) {
+	nodes, _, _ := newGroup(t, 2, nil)
+	a := nodes[0]
+	for _, node := range nodes {
+		node.Stop()
+	}
+
+	// A node back from a partition claims node1 died; node0 only suspects
+	// it.
+	dead := view(a, "node1")
+	dead.State = pb.MemberState_MEMBER_DEAD
+	if _, err := a.Sync(context.Background(), &pb.GossipSync{Members: []*pb.GossipMember{dead}}); err != nil {
+		t.Fatal(err)
+	}
+	if m := view(a, "node1"); m.State != pb.MemberState_MEMBER_SUSPECT {
+		t.Errorf("node1 is %v after a full sync claiming its death, want suspect", m.State)
+	}
+
+	// The same claim through gossip is believed.
+	if _, err := a.Ping(context.Background(), &pb.GossipPing{Target: "node0", Updates: []*pb.GossipMember{dead}}); err != nil {
+		t.Fatal(err)
+	}
+	if m := view(a, "node1"); m.State != pb.MemberState_MEMBER_DEAD {
+		t.Errorf("node1 is %v after gossip of its death, want dead", m.State)
+	}
+}
+
+// cutTransport fails the pings of a node to the addresses in cut.
+type cutTransport struct {
+	Transport
+	cut map[string]bool
+}
+
+func (t *cutTransport) Ping(ctx context.Context, address string, req *pb.GossipPing) (*pb.GossipAck, error) {
+	if t.cut[address] {
+		return nil, ErrUnreachable
+	}
+	return t.Transport.Ping(ctx, address, req)
+}
+
+func TestIndirectProbe(t *testing.T) {
+	// node0 can not ping node2 directly, but the other nodes can.
+	nodes, recorders, _ := newGroup(t, 3, func(address string, tr Transport) Transport {
+		if address == "node0" {
+			return &cutTransport{Transport: tr, cut: map[string]bool{"node2": true}}
+		}
+		return tr
+	})
+
+	// Over many probe rounds, node2 is acked through node1.
+	time.Sleep(50 * nodes[0].cfg.ProbeInterval)
+	for _, state := range recorders[0].seen("node2") {
+		if state != pb.MemberState_MEMBER_ALIVE {
+			t.Fatalf("node0 saw node2 %v although node1 reaches it", state)
+		}
+	}
+	if m := view(nodes[0], "node2"); m.State != pb.MemberState_MEMBER_ALIVE {
+		t.Errorf("node0 holds node2 %v, want alive", m.State)
+	}
+}
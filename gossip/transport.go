@@ -0,0 +1,143 @@
+package gossip
+
+import (
+	"context"
+	"sync"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Transport sends the messages of a node to other nodes, given their
+// address.
+type Transport interface {
+	Ping(ctx context.Context, address string, req *pb.GossipPing) (*pb.GossipAck, error)
+	PingReq(ctx context.Context, address string, req *pb.GossipPingReq) (*pb.GossipAck, error)
+	Sync(ctx context.Context, address string, req *pb.GossipSync) (*pb.GossipSync, error)
+}
+
+// GRPCTransport sends messages to the GossipService of other nodes,
+// keeping one connection per address.
+type GRPCTransport struct {
+	mu      sync.Mutex
+	clients map[string]pb.GossipServiceClient
+}
+
+func NewGRPCTransport() *GRPCTransport {
+	return &GRPCTransport{clients: make(map[string]pb.GossipServiceClient)}
+}
+
+func (t *GRPCTransport) client(address string) (pb.GossipServiceClient, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if client, ok := t.clients[address]; ok {
+		return client, nil
+	}
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	client := pb.NewGossipServiceClient(conn)
+	t.clients[address] = client
+	return client, nil
+}
+
+func (t *GRPCTransport) Ping(ctx context.Context, address string, req *pb.GossipPing) (*pb.GossipAck, error) {
+	client, err := t.client(address)
+	if err != nil {
+		return nil, err
+	}
+	return client.Ping(ctx, req)
+}
+
+func (t *GRPCTransport) PingReq(ctx context.Context, address string, req *pb.GossipPingReq) (*pb.GossipAck, error) {
+	client, err := t.client(address)
+	if err != nil {
+		return nil, err
+	}
+	return client.PingReq(ctx, req)
+}
+
+func (t *GRPCTransport) Sync(ctx context.Context, address string, req *pb.GossipSync) (*pb.GossipSync, error) {
+	client, err := t.client(address)
+	if err != nil {
+		return nil, err
+	}
+	return client.Sync(ctx, req)
+}
+
+// LocalTransport delivers messages between nodes of the same process, so
+// that groups can run without a network. Each node sends through the
+// Transport returned by From for its own address.
+type LocalTransport struct {
+	mu    sync.RWMutex
+	nodes map[string]*Node
+	down  map[string]bool
+}
+
+func NewLocalTransport() *LocalTransport {
+	return &LocalTransport{
+		nodes: make(map[string]*Node),
+		down:  make(map[string]bool),
+	}
+}
+
+// Register makes node reachable at address.
+func (t *LocalTransport) Register(address string, node *Node) {
+	t.mu.Lock()
+	t.nodes[address] = node
+	t.mu.Unlock()
+}
+
+// SetDown cuts the node at address from the others, or reconnects it, as
+// if its network failed.
+func (t *LocalTransport) SetDown(address string, down bool) {
+	t.mu.Lock()
+	t.down[address] = down
+	t.mu.Unlock()
+}
+
+// From returns the transport of the node at address.
+func (t *LocalTransport) From(address string) Transport {
+	return &localSender{t: t, from: address}
+}
+
+type localSender struct {
+	t    *LocalTransport
+	from string
+}
+
+func (s *localSender) node(address string) (*Node, error) {
+	s.t.mu.RLock()
+	defer s.t.mu.RUnlock()
+	node, ok := s.t.nodes[address]
+	if !ok || s.t.down[address] || s.t.down[s.from] {
+		return nil, ErrUnreachable
+	}
+	return node, nil
+}
+
+func (s *localSender) Ping(ctx context.Context, address string, req *pb.GossipPing) (*pb.GossipAck, error) {
+	node, err := s.node(address)
+	if err != nil {
+		return nil, err
+	}
+	return node.Ping(ctx, req)
+}
+
+func (s *localSender) PingReq(ctx context.Context, address string, req *pb.GossipPingReq) (*pb.GossipAck, error) {
+	node, err := s.node(address)
+	if err != nil {
+		return nil, err
+	}
+	return node.PingReq(ctx, req)
+}
+
+func (s *localSender) Sync(ctx context.Context, address string, req *pb.GossipSync) (*pb.GossipSync, error) {
+	node, err := s.node(address)
+	if err != nil {
+		return nil, err
+	}
+	return node.Sync(ctx, req)
+}
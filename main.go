@@ -1,43 +1,312 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
 	"net"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	pb "github.com/shanukun/cash/cash_proto"
+	"github.com/shanukun/cash/cluster"
+	"github.com/shanukun/cash/crdt"
+	"github.com/shanukun/cash/dynamo"
+	"github.com/shanukun/cash/gossip"
+	"github.com/shanukun/cash/raft"
 	service "github.com/shanukun/cash/service"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
 
 var (
-	address string
-	expire  int
-	cleanup int
+	address     string
+	expire      int
+	cleanup     int
+	clusterMode bool
+	nodeID      string
+	advertise   string
+	slots       string
+	raftMode    bool
+	raftPeers   string
+	raftDir     string
+	gossipMode  bool
+	seeds       string
+	role        string
+	replication bool
+	replicaOf   string
+	dynamoMode  bool
+	dynamoPeers string
+	dynamoN     int
+	dynamoW     int
+	dynamoR     int
+	antiEntropy time.Duration
+	repairRate  int
+	multiMaster bool
+	sites       string
 )
 
 func parseFlags() {
 	flag.StringVar(&address, "addr", ":8001", "address")
 	flag.IntVar(&expire, "exp", 7, "expiration (min)")
 	flag.IntVar(&cleanup, "clu", 3, "cleanup after expiration (min)")
+	flag.BoolVar(&clusterMode, "cluster", false, "enable cluster mode")
+	flag.StringVar(&nodeID, "node-id", "", "node id in cluster, Raft, Dynamo, multi-master and gossip modes (default advertised address)")
+	flag.StringVar(&advertise, "advertise", "", "address advertised to clients and nodes (default addr)")
+	flag.StringVar(&slots, "slots", "", "hash slots owned at startup, such as 0-8191,9000")
+	flag.BoolVar(&raftMode, "raft", false, "enable Raft replication")
+	flag.StringVar(&raftPeers, "raft-peers", "", "initial Raft group, such as a=host1:8001,b=host2:8001 (empty to join a group)")
+	flag.StringVar(&raftDir, "raft-dir", "", "directory of the Raft log and snapshots (default in memory)")
+	flag.BoolVar(&gossipMode, "gossip", false, "enable gossip membership")
+	flag.StringVar(&seeds, "seeds", "", "addresses of gossip seeds, such as host1:8001,host2:8001")
+	flag.StringVar(&role, "role", "primary", "role advertised through gossip")
+	flag.BoolVar(&replication, "replication", false, "enable primary/replica replication")
+	flag.StringVar(&replicaOf, "replicaof", "", "address of the primary to replicate (implies -replication)")
+	flag.BoolVar(&dynamoMode, "dynamo", false, "enable leaderless replication on a hash ring")
+	flag.StringVar(&dynamoPeers, "dynamo-peers", "", "nodes of the ring, such as a=host1:8001,b=host2:8001")
+	flag.IntVar(&dynamoN, "dynamo-n", 0, "number of replicas of each key (default 3)")
+	flag.IntVar(&dynamoW, "dynamo-w", 0, "default number of replicas acknowledging writes (default majority)")
+	flag.IntVar(&dynamoR, "dynamo-r", 0, "default number of replicas answering reads (default majority)")
+	flag.DurationVar(&antiEntropy, "dynamo-anti-entropy", 0, "time between anti-entropy rounds, negative to disable (default 1m)")
+	flag.IntVar(&repairRate, "dynamo-repair-rate", 0, "keys repaired by anti-entropy per second (default 1000)")
+	flag.BoolVar(&multiMaster, "multimaster", false, "enable active-active replication between sites")
+	flag.StringVar(&sites, "sites", "", "sites of the multi-master mode, such as a=host1:8001,b=host2:8001")
 	flag.Parse()
+
+	if advertise == "" {
+		advertise = address
+	}
+	if nodeID == "" {
+		nodeID = advertise
+	}
+	if replicaOf != "" {
+		replication = true
+	}
+}
+
+// assignSlots assigns the comma separated slots and slot ranges in spec to
+// the local node.
+func assignSlots(c *cluster.Cluster, spec string) error {
+	for _, r := range strings.Split(spec, ",") {
+		if r == "" {
+			continue
+		}
+		bounds := strings.SplitN(r, "-", 2)
+		start, err := strconv.Atoi(bounds[0])
+		if err != nil {
+			return err
+		}
+		end := start
+		if len(bounds) == 2 {
+			if end, err = strconv.Atoi(bounds[1]); err != nil {
+				return err
+			}
+		}
+		if err := c.Assign(start, end, c.Self()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parsePeers parses the comma separated id=address pairs of spec.
+func parsePeers(spec string) ([]*pb.RaftMember, error) {
+	var members []*pb.RaftMember
+	for _, p := range strings.Split(spec, ",") {
+		if p == "" {
+			continue
+		}
+		id, addr, ok := strings.Cut(p, "=")
+		if !ok || id == "" || addr == "" {
+			return nil, fmt.Errorf("expected id=address, got %q", p)
+		}
+		members = append(members, &pb.RaftMember{Id: id, Address: addr})
+	}
+	return members, nil
+}
+
+// advertiseMeta keeps the role and the slots gossiped by the node up to
+// date. In Raft mode, the role is the Raft state of the node, and in
+// replication mode whether it is a primary or a replica.
+func advertiseMeta(g *gossip.Node, cl *cluster.Cluster, rs *service.Raft, rp *service.Replication) {
+	for range time.Tick(time.Second) {
+		r := role
+		if rs != nil {
+			r = rs.Node().State().State
+		}
+		if rp != nil {
+			r = rp.State().Role
+		}
+		var owned []*pb.SlotRange
+		if cl != nil {
+			for _, s := range cl.State().Slots {
+				if s.Node == cl.Self() {
+					owned = append(owned, s)
+				}
+			}
+		}
+		g.SetMeta(r, owned, nil)
+	}
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "sentinel" {
+		runSentinel(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "proxy" {
+		runProxy(os.Args[2:])
+		return
+	}
+
 	parseFlags()
+	if clusterMode && raftMode {
+		log.Fatalf("cluster and Raft modes can not be combined")
+	}
+	if replication && (clusterMode || raftMode) {
+		log.Fatalf("replication can not be combined with cluster or Raft mode")
+	}
+	if dynamoMode && (clusterMode || raftMode || replication) {
+		log.Fatalf("Dynamo mode can not be combined with cluster, Raft or replication mode")
+	}
+	if multiMaster && (clusterMode || raftMode || replication || dynamoMode) {
+		log.Fatalf("multi-master mode can not be combined with cluster, Raft, replication or Dynamo mode")
+	}
 
 	opts := []grpc.ServerOption{
 		grpc.MaxConcurrentStreams(100),
 	}
 
+	cache := service.NewCacheService(time.Duration(expire)*time.Minute,
+		time.Duration(cleanup)*time.Minute)
+
+	var cl *cluster.Cluster
+	if clusterMode {
+		cl = cluster.New(nodeID, advertise, cache)
+		if err := assignSlots(cl, slots); err != nil {
+			log.Fatalf("invalid slots %q: %v", slots, err)
+		}
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(cl.UnaryInterceptor()),
+			grpc.ChainStreamInterceptor(cl.StreamInterceptor()))
+	}
+
+	var rs *service.Raft
+	if raftMode {
+		members, err := parsePeers(raftPeers)
+		if err != nil {
+			log.Fatalf("invalid Raft peers %q: %v", raftPeers, err)
+		}
+		cfg := raft.Config{
+			ID:      nodeID,
+			Address: advertise,
+			Members: members,
+		}
+		if raftDir != "" {
+			if cfg.Storage, err = raft.NewFileStorage(raftDir); err != nil {
+				log.Fatalf("Raft storage error %v", err)
+			}
+		}
+		if rs, err = service.NewRaft(cache, cfg); err != nil {
+			log.Fatalf("Raft error %v", err)
+		}
+		opts = append(opts, grpc.ChainUnaryInterceptor(rs.UnaryInterceptor()))
+	}
+
+	var rp *service.Replication
+	if replication {
+		rp = service.NewReplication(cache, advertise)
+		opts = append(opts, grpc.ChainUnaryInterceptor(rp.UnaryInterceptor()))
+	}
+
+	var dy *service.Dynamo
+	if dynamoMode {
+		peers, err := parsePeers(dynamoPeers)
+		if err != nil {
+			log.Fatalf("invalid Dynamo peers %q: %v", dynamoPeers, err)
+		}
+		cfg := dynamo.Config{
+			ID:          nodeID,
+			Address:     advertise,
+			Replicas:    dynamoN,
+			WriteQuorum: dynamoW,
+			ReadQuorum:  dynamoR,
+
+			AntiEntropyInterval: antiEntropy,
+			RepairRate:          repairRate,
+		}
+		for _, p := range peers {
+			cfg.Members = append(cfg.Members, &pb.DynamoMember{Id: p.Id, Address: p.Address})
+		}
+		if dy, err = service.NewDynamo(cache, cfg); err != nil {
+			log.Fatalf("Dynamo error %v", err)
+		}
+		opts = append(opts, grpc.ChainUnaryInterceptor(dy.UnaryInterceptor()))
+	}
+
+	var mm *service.MultiMaster
+	if multiMaster {
+		peers, err := parsePeers(sites)
+		if err != nil {
+			log.Fatalf("invalid sites %q: %v", sites, err)
+		}
+		cfg := crdt.Config{
+			ID:      nodeID,
+			Address: advertise,
+		}
+		for _, p := range peers {
+			cfg.Sites = append(cfg.Sites, &pb.MultiMasterSite{Id: p.Id, Address: p.Address})
+		}
+		mm = service.NewMultiMaster(cache, cfg)
+		opts = append(opts, grpc.ChainUnaryInterceptor(mm.UnaryInterceptor()))
+	}
+
 	grpcServer := grpc.NewServer(opts...)
-	pb.RegisterCacheServiceServer(
-		grpcServer,
-		service.NewCacheService(time.Duration(expire)*time.Minute,
-			time.Duration(cleanup)*time.Minute))
+	pb.RegisterCacheServiceServer(grpcServer, cache)
+	if cl != nil {
+		pb.RegisterClusterServiceServer(grpcServer, cl)
+	}
+	if rs != nil {
+		pb.RegisterRaftServiceServer(grpcServer, rs.Node())
+		rs.Start()
+	}
+	if rp != nil {
+		pb.RegisterReplicationServiceServer(grpcServer, rp)
+		rp.Start()
+		if replicaOf != "" {
+			if _, err := rp.ReplicaOf(context.Background(), &pb.ReplicaOfRequest{Address: replicaOf}); err != nil {
+				log.Fatalf("replication error %v", err)
+			}
+		}
+	}
+	if dy != nil {
+		pb.RegisterDynamoServiceServer(grpcServer, dy.Node())
+		dy.Start()
+	}
+	if mm != nil {
+		pb.RegisterMultiMasterServiceServer(grpcServer, mm.Node())
+		mm.Start()
+	}
+	if gossipMode {
+		var seedList []string
+		for _, s := range strings.Split(seeds, ",") {
+			if s != "" {
+				seedList = append(seedList, s)
+			}
+		}
+		g := gossip.New(gossip.Config{
+			ID:      nodeID,
+			Address: advertise,
+			Role:    role,
+			Seeds:   seedList,
+		})
+		pb.RegisterGossipServiceServer(grpcServer, g)
+		g.Start()
+		go advertiseMeta(g, cl, rs, rp)
+	}
 
 	reflection.Register(grpcServer)
 
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/shanukun/cash/proxy"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// runProxy runs the proxy command, which serves the CacheService of a set
+// of backends behind a single address.
+func runProxy(args []string) {
+	fs := flag.NewFlagSet("proxy", flag.ExitOnError)
+	addr := fs.String("addr", ":8000", "address")
+	backends := fs.String("backends", "", "addresses of the backends, such as host1:8001,host2:8001")
+	mode := fs.String("mode", proxy.ModeSlots, "routing of keys: slots to follow the slot map of a cluster, hash for a consistent hash ring")
+	respAddr := fs.String("resp", "", "address serving the RESP protocol, such as :6379 (default disabled)")
+	healthInterval := fs.Duration("health-interval", time.Second, "time between two health checks of the backends")
+	retries := fs.Int("retries", 0, "times a request is retried after a redirect, or a read after a backend failure (default 3)")
+	fs.Parse(args)
+
+	var backendList []string
+	for _, b := range strings.Split(*backends, ",") {
+		if b != "" {
+			backendList = append(backendList, b)
+		}
+	}
+	p, err := proxy.New(proxy.Config{
+		Backends:       backendList,
+		Mode:           *mode,
+		HealthInterval: *healthInterval,
+		Retries:        *retries,
+	})
+	if err != nil {
+		log.Fatalf("proxy error %v", err)
+	}
+	grpcServer := grpc.NewServer()
+	p.Register(grpcServer)
+	reflection.Register(grpcServer)
+	p.Start()
+
+	if *respAddr != "" {
+		respLis, err := net.Listen("tcp", *respAddr)
+		if err != nil {
+			log.Fatalf("start error %v", err)
+		}
+		fmt.Println("RESP proxy running on:", *respAddr)
+		go func() {
+			if err := p.ServeRESP(respLis); err != nil {
+				log.Fatalf("RESP server failed: %v\n", err)
+			}
+		}()
+	}
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("start error %v", err)
+	}
+	fmt.Println("proxy running on:", *addr)
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("grpc server failed: %v\n", err)
+	}
+}
@@ -0,0 +1,233 @@
+package proxy
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultLimit is the number of results of queries without a limit, as on
+// the backends.
+const defaultLimit = 10
+
+// fanouts are the methods sent to every backend, merging their responses.
+var fanouts = map[string]func(ctx context.Context, p *Proxy, name string, req proto.Message) (proto.Message, error){
+	"DeleteAll":    broadcast,
+	"CreateIndex":  broadcast,
+	"DropIndex":    broadcast,
+	"FTCreate":     broadcast,
+	"FTDrop":       broadcast,
+	"Publish":      publish,
+	"IndexInfo":    indexInfo,
+	"FTInfo":       searchInfo,
+	"QueryIndex":   queryIndex,
+	"FTSearch":     search,
+	"TSQueryIndex": queryLabels,
+}
+
+// shards returns the backends holding keys: the owners of slots in
+// ModeSlots, and the healthy backends in ModeHash, whose keys are served
+// by the next backends while they are down.
+func (p *Proxy) shards() []string {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	seen := make(map[string]bool)
+	var addresses []string
+	if p.cfg.Mode == ModeSlots {
+		for _, address := range p.slots {
+			if address != "" && !seen[address] {
+				seen[address] = true
+				addresses = append(addresses, address)
+			}
+		}
+		if len(addresses) > 0 {
+			return addresses
+		}
+	}
+	for _, address := range p.cfg.Backends {
+		if p.cfg.Mode == ModeSlots || p.backends[address].healthy {
+			addresses = append(addresses, address)
+		}
+	}
+	return addresses
+}
+
+// scatter sends req to every shard and returns their responses, or the
+// first error.
+func (p *Proxy) scatter(ctx context.Context, name string, req proto.Message) ([]proto.Message, error) {
+	addresses := p.shards()
+	if len(addresses) == 0 {
+		return nil, status.Error(codes.Unavailable, ErrNoBackend.Error())
+	}
+	resps := make([]proto.Message, len(addresses))
+	errs := make([]error, len(addresses))
+	var wg sync.WaitGroup
+	for i, address := range addresses {
+		wg.Add(1)
+		go func(i int, address string) {
+			defer wg.Done()
+			resps[i], errs[i] = p.call(ctx, address, name, req, false)
+			if isDown(errs[i]) {
+				p.setHealthy(address, false)
+			}
+		}(i, address)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return resps, nil
+}
+
+func broadcast(ctx context.Context, p *Proxy, name string, req proto.Message) (proto.Message, error) {
+	resps, err := p.scatter(ctx, name, req)
+	if err != nil {
+		return nil, err
+	}
+	return resps[0], nil
+}
+
+func publish(ctx context.Context, p *Proxy, name string, req proto.Message) (proto.Message, error) {
+	resps, err := p.scatter(ctx, name, req)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.PublishResult{}
+	for _, r := range resps {
+		res.Receivers += r.(*pb.PublishResult).Receivers
+	}
+	return res, nil
+}
+
+func indexInfo(ctx context.Context, p *Proxy, name string, req proto.Message) (proto.Message, error) {
+	resps, err := p.scatter(ctx, name, req)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.IndexInfoResult{Definition: resps[0].(*pb.IndexInfoResult).Definition}
+	for _, r := range resps {
+		res.Keys += r.(*pb.IndexInfoResult).Keys
+	}
+	return res, nil
+}
+
+// searchInfo sums the keys and terms of the backends; terms found on
+// several backends are counted once by each.
+func searchInfo(ctx context.Context, p *Proxy, name string, req proto.Message) (proto.Message, error) {
+	resps, err := p.scatter(ctx, name, req)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.SearchIndexInfo{Definition: resps[0].(*pb.SearchIndexInfo).Definition}
+	for _, r := range resps {
+		info := r.(*pb.SearchIndexInfo)
+		res.Keys += info.Keys
+		res.Terms += info.Terms
+	}
+	return res, nil
+}
+
+// page returns the bounds of the page of offset and limit among n
+// results.
+func page(offset, limit int64, n int) (int, int) {
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	start, end := int(offset), int(offset+limit)
+	if start > n || start < 0 {
+		start = n
+	}
+	if end > n {
+		end = n
+	}
+	return start, end
+}
+
+// queryIndex merges the keys of the backends in key order. The backends
+// sort by field on their own values, which the proxy can not merge.
+func queryIndex(ctx context.Context, p *Proxy, name string, req proto.Message) (proto.Message, error) {
+	q := req.(*pb.IndexQuery)
+	if len(p.shards()) == 1 {
+		return broadcast(ctx, p, name, req)
+	}
+	if q.SortBy != "" {
+		return nil, status.Error(codes.InvalidArgument, "Sorting by field is not supported across backends")
+	}
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	all := proto.Clone(q).(*pb.IndexQuery)
+	all.Offset, all.Limit = 0, q.Offset+limit
+	resps, err := p.scatter(ctx, name, all)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.IndexQueryResult{}
+	var keys []string
+	for _, r := range resps {
+		qr := r.(*pb.IndexQueryResult)
+		res.Total += qr.Total
+		keys = append(keys, qr.Keys...)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return (keys[i] < keys[j]) != q.Desc
+	})
+	start, end := page(q.Offset, limit, len(keys))
+	res.Keys = keys[start:end]
+	return res, nil
+}
+
+// search merges the results of the backends by score. Scores are computed
+// by each backend from its own keys.
+func search(ctx context.Context, p *Proxy, name string, req proto.Message) (proto.Message, error) {
+	q := req.(*pb.SearchQuery)
+	limit := q.Limit
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	all := proto.Clone(q).(*pb.SearchQuery)
+	all.Offset, all.Limit = 0, q.Offset+limit
+	resps, err := p.scatter(ctx, name, all)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.SearchResults{}
+	var results []*pb.SearchResult
+	for _, r := range resps {
+		sr := r.(*pb.SearchResults)
+		res.Total += sr.Total
+		results = append(results, sr.Results...)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Key < results[j].Key
+	})
+	start, end := page(q.Offset, limit, len(results))
+	res.Results = results[start:end]
+	return res, nil
+}
+
+func queryLabels(ctx context.Context, p *Proxy, name string, req proto.Message) (proto.Message, error) {
+	resps, err := p.scatter(ctx, name, req)
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.Keys{}
+	for _, r := range resps {
+		res.Keys = append(res.Keys, r.(*pb.Keys).Keys...)
+	}
+	sort.Strings(res.Keys)
+	return res, nil
+}
@@ -0,0 +1,355 @@
+// Package proxy serves the CacheService of a set of backend nodes behind a
+// single address. Each request is sent to the backend owning its keys,
+// found from the slot map of a cluster or on a consistent hash ring of the
+// backends. Requests without keys, such as DeleteAll and index queries,
+// are sent to every backend and their responses merged. Backends are
+// checked in the background, and reads from a backend which fails are
+// retried on the backend taking over its keys.
+package proxy
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/shanukun/cash/cash_proto"
+	"github.com/shanukun/cash/cluster"
+	"github.com/shanukun/cash/dynamo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+var (
+	ErrNoBackend    = errors.New("No backend available")
+	ErrInvalidMode  = errors.New("Routing mode must be slots or hash")
+	ErrNoBackends   = errors.New("Proxy needs at least one backend")
+	errUnknownRoute = errors.New("Unknown method")
+)
+
+// Modes of routing.
+const (
+	// ModeSlots routes keys by the slot map of the cluster of the
+	// backends, learned from them and from their redirects.
+	ModeSlots = "slots"
+	// ModeHash routes keys on a consistent hash ring of the backends,
+	// skipping the backends which are down.
+	ModeHash = "hash"
+)
+
+const (
+	defaultHealthInterval = time.Second
+	defaultHealthTimeout  = 500 * time.Millisecond
+	defaultRetries        = 3
+	retryBackoff          = 50 * time.Millisecond
+)
+
+type Config struct {
+	// Backends are the addresses of the nodes served.
+	Backends []string
+	// Mode is ModeSlots or ModeHash, ModeSlots unless set.
+	Mode string
+	// HealthInterval is the time between two checks of every backend,
+	// which also refresh the slot map.
+	HealthInterval time.Duration
+	HealthTimeout  time.Duration
+	// Retries is the number of times a request is sent again after a
+	// backend redirected it, or a read after a backend failed.
+	Retries int
+}
+
+type backend struct {
+	address string
+	conn    *grpc.ClientConn
+	healthy bool
+}
+
+type method struct {
+	in, out protoreflect.MessageType
+	stream  bool
+}
+
+// Proxy serves the CacheService of the backends.
+type Proxy struct {
+	cfg     Config
+	ring    *dynamo.Ring
+	methods map[string]*method
+
+	mu       sync.RWMutex
+	backends map[string]*backend
+	// slots maps the slots to the address of their owner, in ModeSlots.
+	slots   [cluster.NumSlots]string
+	epoch   int64
+	stopped chan struct{}
+}
+
+// New returns the proxy of cfg.Backends, to be started with Start.
+func New(cfg Config) (*Proxy, error) {
+	if len(cfg.Backends) == 0 {
+		return nil, ErrNoBackends
+	}
+	if cfg.Mode == "" {
+		cfg.Mode = ModeSlots
+	}
+	if cfg.Mode != ModeSlots && cfg.Mode != ModeHash {
+		return nil, ErrInvalidMode
+	}
+	if cfg.HealthInterval == 0 {
+		cfg.HealthInterval = defaultHealthInterval
+	}
+	if cfg.HealthTimeout == 0 {
+		cfg.HealthTimeout = defaultHealthTimeout
+	}
+	if cfg.Retries == 0 {
+		cfg.Retries = defaultRetries
+	}
+
+	methods, err := cacheMethods()
+	if err != nil {
+		return nil, err
+	}
+	p := &Proxy{
+		cfg:      cfg,
+		ring:     dynamo.NewRing(cfg.Backends),
+		methods:  methods,
+		backends: make(map[string]*backend),
+		stopped:  make(chan struct{}),
+	}
+	for _, address := range cfg.Backends {
+		if _, err := p.backend(address); err != nil {
+			return nil, err
+		}
+	}
+	return p, nil
+}
+
+// cacheMethods returns the request and response types of the methods of
+// CacheService.
+func cacheMethods() (map[string]*method, error) {
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(pb.CacheService_ServiceDesc.ServiceName))
+	if err != nil {
+		return nil, err
+	}
+	sd := d.(protoreflect.ServiceDescriptor)
+	methods := make(map[string]*method)
+	for i := 0; i < sd.Methods().Len(); i++ {
+		md := sd.Methods().Get(i)
+		in, err := protoregistry.GlobalTypes.FindMessageByName(md.Input().FullName())
+		if err != nil {
+			return nil, err
+		}
+		out, err := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+		if err != nil {
+			return nil, err
+		}
+		methods[string(md.Name())] = &method{in: in, out: out, stream: md.IsStreamingServer()}
+	}
+	return methods, nil
+}
+
+// backend returns the backend at address, connecting to it the first
+// time. Backends start healthy.
+func (p *Proxy) backend(address string) (*backend, error) {
+	p.mu.RLock()
+	b, ok := p.backends[address]
+	p.mu.RUnlock()
+	if ok {
+		return b, nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if b, ok := p.backends[address]; ok {
+		return b, nil
+	}
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	b = &backend{address: address, conn: conn, healthy: true}
+	p.backends[address] = b
+	return b, nil
+}
+
+func (p *Proxy) setHealthy(address string, healthy bool) {
+	p.mu.Lock()
+	if b, ok := p.backends[address]; ok {
+		b.healthy = healthy
+	}
+	p.mu.Unlock()
+}
+
+// Start checks the backends in the background.
+func (p *Proxy) Start() {
+	p.check()
+	go p.run()
+}
+
+func (p *Proxy) Stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	select {
+	case <-p.stopped:
+	default:
+		close(p.stopped)
+	}
+}
+
+func (p *Proxy) run() {
+	ticker := time.NewTicker(p.cfg.HealthInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-p.stopped:
+			return
+		case <-ticker.C:
+		}
+		p.check()
+	}
+}
+
+// check asks every backend for its slot map: backends which do not answer
+// are down, and the newest slot map answered is kept.
+func (p *Proxy) check() {
+	p.mu.RLock()
+	backends := make([]*backend, 0, len(p.backends))
+	for _, b := range p.backends {
+		backends = append(backends, b)
+	}
+	p.mu.RUnlock()
+
+	var wg sync.WaitGroup
+	for _, b := range backends {
+		wg.Add(1)
+		go func(b *backend) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), p.cfg.HealthTimeout)
+			defer cancel()
+			st, err := pb.NewClusterServiceClient(b.conn).ClusterInfo(ctx, &empty.Empty{})
+			// Backends outside cluster mode answer Unimplemented.
+			p.setHealthy(b.address, !isDown(err))
+			if err == nil && p.cfg.Mode == ModeSlots {
+				p.setSlots(st)
+			}
+		}(b)
+	}
+	wg.Wait()
+}
+
+// isDown reports whether err means that the backend could not be reached.
+// Dynamo backends answer Unavailable with NOQUORUM when too few replicas
+// answer, which is not their failure.
+func isDown(err error) bool {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.Unavailable:
+		return !strings.HasPrefix(st.Message(), "NOQUORUM")
+	case codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+// setSlots keeps the slot map of st if it is newer than the current one.
+func (p *Proxy) setSlots(st *pb.ClusterState) {
+	addresses := make(map[string]string)
+	for _, n := range st.Nodes {
+		addresses[n.Id] = n.Address
+	}
+	p.mu.Lock()
+	if st.Epoch < p.epoch || len(st.Slots) == 0 {
+		p.mu.Unlock()
+		return
+	}
+	p.epoch = st.Epoch
+	p.slots = [cluster.NumSlots]string{}
+	for _, r := range st.Slots {
+		for s := r.Start; s <= r.End; s++ {
+			p.slots[s] = addresses[r.Node]
+		}
+	}
+	p.mu.Unlock()
+
+	for _, address := range addresses {
+		p.backend(address)
+	}
+}
+
+// State returns the backends and whether they are healthy.
+func (p *Proxy) State() map[string]bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	state := make(map[string]bool)
+	for address, b := range p.backends {
+		state[address] = b.healthy
+	}
+	return state
+}
+
+// Register serves the CacheService of the backends on s.
+func (p *Proxy) Register(s *grpc.Server) {
+	sd := grpc.ServiceDesc{
+		ServiceName: pb.CacheService_ServiceDesc.ServiceName,
+		// The proxy serves every method through the same handlers
+		// rather than implementing CacheServiceServer.
+		HandlerType: (*interface{})(nil),
+		Metadata:    pb.CacheService_ServiceDesc.Metadata,
+	}
+	for _, m := range pb.CacheService_ServiceDesc.Methods {
+		sd.Methods = append(sd.Methods, grpc.MethodDesc{
+			MethodName: m.MethodName,
+			Handler:    p.unaryHandler(m.MethodName),
+		})
+	}
+	for _, st := range pb.CacheService_ServiceDesc.Streams {
+		sd.Streams = append(sd.Streams, grpc.StreamDesc{
+			StreamName:    st.StreamName,
+			Handler:       p.streamHandler(st.StreamName),
+			ServerStreams: st.ServerStreams,
+			ClientStreams: st.ClientStreams,
+		})
+	}
+	s.RegisterService(&sd, p)
+}
+
+func fullMethod(name string) string {
+	return "/" + pb.CacheService_ServiceDesc.ServiceName + "/" + name
+}
+
+func (p *Proxy) unaryHandler(name string) func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		req := p.methods[name].in.New().Interface()
+		if err := dec(req); err != nil {
+			return nil, err
+		}
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return p.Invoke(ctx, name, req.(proto.Message))
+		}
+		if interceptor == nil {
+			return handler(ctx, req)
+		}
+		info := &grpc.UnaryServerInfo{Server: srv, FullMethod: fullMethod(name)}
+		return interceptor(ctx, req, info, handler)
+	}
+}
+
+// Invoke runs the request to the method name of CacheService, such as Get,
+// on the backends.
+func (p *Proxy) Invoke(ctx context.Context, name string, req proto.Message) (proto.Message, error) {
+	m, ok := p.methods[name]
+	if !ok || m.stream {
+		return nil, status.Errorf(codes.Unimplemented, "%v: %s", errUnknownRoute, name)
+	}
+	ctx = outgoing(ctx)
+	if fan, ok := fanouts[name]; ok {
+		return fan(ctx, p, name, req)
+	}
+	return p.forward(ctx, name, req, cluster.RequestKeys(req))
+}
@@ -0,0 +1,391 @@
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/shanukun/cash/cash_proto"
+	"google.golang.org/grpc/status"
+)
+
+// Errors of the RESP protocol follow the wording of Redis.
+var (
+	errProtocol   = errors.New("Protocol error")
+	errSyntax     = errors.New("syntax error")
+	errNotInteger = errors.New("value is not an integer or out of range")
+)
+
+// respCommands are the commands of the RESP protocol served, as calls to
+// CacheService. Their replies are those of Redis, except DEL and HSET,
+// which count the keys and fields given since the backends do not tell
+// whether they existed.
+var respCommands = map[string]func(ctx context.Context, p *Proxy, args []string) (interface{}, error){
+	"PING": func(_ context.Context, _ *Proxy, args []string) (interface{}, error) {
+		if len(args) > 0 {
+			return args[0], nil
+		}
+		return simple("PONG"), nil
+	},
+	"GET": func(ctx context.Context, p *Proxy, args []string) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, errArgs("get")
+		}
+		resp, err := p.Invoke(ctx, "Get", &pb.Key{Key: args[0]})
+		if err != nil {
+			return nil, err
+		}
+		return resp.(*pb.String).Value, nil
+	},
+	"SET": func(ctx context.Context, p *Proxy, args []string) (interface{}, error) {
+		if len(args) != 2 && len(args) != 4 {
+			return nil, errArgs("set")
+		}
+		req := &pb.String{Key: args[0], Value: args[1]}
+		if len(args) == 4 {
+			exp, err := expiration(args[2], args[3])
+			if err != nil {
+				return nil, err
+			}
+			req.Expiration = exp
+		}
+		if _, err := p.Invoke(ctx, "Set", req); err != nil {
+			return nil, err
+		}
+		return simple("OK"), nil
+	},
+	"GETSET": func(ctx context.Context, p *Proxy, args []string) (interface{}, error) {
+		if len(args) != 2 {
+			return nil, errArgs("getset")
+		}
+		resp, err := p.Invoke(ctx, "GetSet", &pb.String{Key: args[0], Value: args[1]})
+		if err != nil {
+			return nil, err
+		}
+		return resp.(*pb.String).Value, nil
+	},
+	"DEL": func(ctx context.Context, p *Proxy, args []string) (interface{}, error) {
+		if len(args) == 0 {
+			return nil, errArgs("del")
+		}
+		for _, key := range args {
+			if _, err := p.Invoke(ctx, "DeleteKey", &pb.Key{Key: key}); err != nil {
+				return nil, err
+			}
+		}
+		return int64(len(args)), nil
+	},
+	"INCR": func(ctx context.Context, p *Proxy, args []string) (interface{}, error) {
+		return incrBy(ctx, p, "incr", args, 1)
+	},
+	"DECR": func(ctx context.Context, p *Proxy, args []string) (interface{}, error) {
+		return incrBy(ctx, p, "decr", args, -1)
+	},
+	"INCRBY": func(ctx context.Context, p *Proxy, args []string) (interface{}, error) {
+		return incrBy(ctx, p, "incrby", args, 1)
+	},
+	"DECRBY": func(ctx context.Context, p *Proxy, args []string) (interface{}, error) {
+		return incrBy(ctx, p, "decrby", args, -1)
+	},
+	"LPUSH": func(ctx context.Context, p *Proxy, args []string) (interface{}, error) {
+		return push(ctx, p, "LPush", args)
+	},
+	"RPUSH": func(ctx context.Context, p *Proxy, args []string) (interface{}, error) {
+		return push(ctx, p, "RPush", args)
+	},
+	"LRANGE": func(ctx context.Context, p *Proxy, args []string) (interface{}, error) {
+		if len(args) != 3 {
+			return nil, errArgs("lrange")
+		}
+		start, err1 := strconv.Atoi(args[1])
+		stop, err2 := strconv.Atoi(args[2])
+		if err1 != nil || err2 != nil {
+			return nil, errNotInteger
+		}
+		list, err := members(ctx, p, "GetList", args[0])
+		if err != nil {
+			return nil, err
+		}
+		if start < 0 {
+			start += len(list)
+		}
+		if stop < 0 {
+			stop += len(list)
+		}
+		if start < 0 {
+			start = 0
+		}
+		if stop >= len(list) {
+			stop = len(list) - 1
+		}
+		if start > stop {
+			return []string{}, nil
+		}
+		return list[start : stop+1], nil
+	},
+	"HSET": func(ctx context.Context, p *Proxy, args []string) (interface{}, error) {
+		if len(args) < 3 || len(args)%2 == 0 {
+			return nil, errArgs("hset")
+		}
+		for i := 1; i < len(args); i += 2 {
+			if _, err := p.Invoke(ctx, "HMSet", &pb.HashMapItem{Key: args[0], Field: args[i], Value: args[i+1]}); err != nil {
+				return nil, err
+			}
+		}
+		return int64(len(args) / 2), nil
+	},
+	"HGETALL": func(ctx context.Context, p *Proxy, args []string) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, errArgs("hgetall")
+		}
+		return members(ctx, p, "GetHashMap", args[0])
+	},
+	"SADD": func(ctx context.Context, p *Proxy, args []string) (interface{}, error) {
+		return setMembers(ctx, p, "SAdd", args)
+	},
+	"SREM": func(ctx context.Context, p *Proxy, args []string) (interface{}, error) {
+		return setMembers(ctx, p, "SRem", args)
+	},
+	"SMEMBERS": func(ctx context.Context, p *Proxy, args []string) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, errArgs("smembers")
+		}
+		return members(ctx, p, "SMembers", args[0])
+	},
+	"FLUSHALL": func(ctx context.Context, p *Proxy, args []string) (interface{}, error) {
+		if _, err := p.Invoke(ctx, "DeleteAll", &empty.Empty{}); err != nil {
+			return nil, err
+		}
+		return simple("OK"), nil
+	},
+	"PUBLISH": func(ctx context.Context, p *Proxy, args []string) (interface{}, error) {
+		if len(args) != 2 {
+			return nil, errArgs("publish")
+		}
+		resp, err := p.Invoke(ctx, "Publish", &pb.Message{Channel: args[0], Message: args[1]})
+		if err != nil {
+			return nil, err
+		}
+		return resp.(*pb.PublishResult).Receivers, nil
+	},
+}
+
+// simple is a status reply, such as OK.
+type simple string
+
+func errArgs(command string) error {
+	return fmt.Errorf("wrong number of arguments for '%s' command", command)
+}
+
+// expiration returns the expiration of the EX or PX option of SET.
+func expiration(option, value string) (string, error) {
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n <= 0 {
+		return "", errNotInteger
+	}
+	switch strings.ToUpper(option) {
+	case "EX":
+		return value + "s", nil
+	case "PX":
+		return value + "ms", nil
+	}
+	return "", errSyntax
+}
+
+func incrBy(ctx context.Context, p *Proxy, command string, args []string, sign int64) (interface{}, error) {
+	delta := int64(1)
+	if strings.HasSuffix(command, "by") {
+		if len(args) != 2 {
+			return nil, errArgs(command)
+		}
+		d, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return nil, errNotInteger
+		}
+		delta = d
+	} else if len(args) != 1 {
+		return nil, errArgs(command)
+	}
+	resp, err := p.Invoke(ctx, "IncrBy", &pb.CounterRequest{Key: args[0], Delta: sign * delta})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.Count).Count, nil
+}
+
+// push pushes the values of args to a list and returns its length.
+func push(ctx context.Context, p *Proxy, name string, args []string) (interface{}, error) {
+	if len(args) < 2 {
+		return nil, errArgs(strings.ToLower(name))
+	}
+	for _, value := range args[1:] {
+		if _, err := p.Invoke(ctx, name, &pb.String{Key: args[0], Value: value}); err != nil {
+			return nil, err
+		}
+	}
+	list, err := members(ctx, p, "GetList", args[0])
+	if err != nil {
+		return nil, err
+	}
+	return int64(len(list)), nil
+}
+
+func setMembers(ctx context.Context, p *Proxy, name string, args []string) (interface{}, error) {
+	if len(args) < 2 {
+		return nil, errArgs(strings.ToLower(name))
+	}
+	resp, err := p.Invoke(ctx, name, &pb.SetMembersRequest{Key: args[0], Members: args[1:]})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.Count).Count, nil
+}
+
+// members returns the list of a method returning a pb.List, empty for
+// missing keys.
+func members(ctx context.Context, p *Proxy, name, key string) ([]string, error) {
+	resp, err := p.Invoke(ctx, name, &pb.Key{Key: key})
+	if missing(err) {
+		return []string{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.List).List, nil
+}
+
+// missing reports whether err is the answer of a backend to a key which
+// does not exist.
+func missing(err error) bool {
+	msg := status.Convert(err).Message()
+	return err != nil && (msg == "No key found" || msg == "Key expired")
+}
+
+// ServeRESP serves the commands of respCommands to the RESP clients
+// connecting on lis, such as redis-cli, until lis is closed.
+func (p *Proxy) ServeRESP(lis net.Listener) error {
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			return err
+		}
+		go p.serveConn(conn)
+	}
+}
+
+func (p *Proxy) serveConn(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	w := bufio.NewWriter(conn)
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			if err != io.EOF {
+				writeReply(w, err)
+				w.Flush()
+			}
+			return
+		}
+		if len(args) == 0 {
+			continue
+		}
+		writeReply(w, p.runCommand(args))
+		// Replies of pipelined commands are sent together.
+		if r.Buffered() == 0 {
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (p *Proxy) runCommand(args []string) interface{} {
+	name := strings.ToUpper(args[0])
+	if name == "QUIT" {
+		return simple("OK")
+	}
+	command, ok := respCommands[name]
+	if !ok {
+		return fmt.Errorf("unknown command '%s'", args[0])
+	}
+	reply, err := command(context.Background(), p, args[1:])
+	if missing(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return reply
+}
+
+// readCommand reads a command sent as an array of bulk strings, or inline
+// as words on a line.
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(line, "*") {
+		return strings.Fields(line), nil
+	}
+	n, err := strconv.Atoi(line[1:])
+	if err != nil {
+		return nil, errProtocol
+	}
+	args := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		line, err := readLine(r)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.HasPrefix(line, "$") {
+			return nil, errProtocol
+		}
+		size, err := strconv.Atoi(line[1:])
+		if err != nil || size < 0 {
+			return nil, errProtocol
+		}
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		args = append(args, string(buf[:size]))
+	}
+	return args, nil
+}
+
+func readLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func writeReply(w *bufio.Writer, reply interface{}) {
+	switch v := reply.(type) {
+	case nil:
+		w.WriteString("$-1\r\n")
+	case simple:
+		fmt.Fprintf(w, "+%s\r\n", v)
+	case error:
+		msg := v.Error()
+		if st, ok := status.FromError(v); ok {
+			msg = st.Message()
+		}
+		fmt.Fprintf(w, "-ERR %s\r\n", strings.ReplaceAll(msg, "\n", " "))
+	case int64:
+		fmt.Fprintf(w, ":%d\r\n", v)
+	case string:
+		fmt.Fprintf(w, "$%d\r\n%s\r\n", len(v), v)
+	case []string:
+		fmt.Fprintf(w, "*%d\r\n", len(v))
+		for _, s := range v {
+			fmt.Fprintf(w, "$%d\r\n%s\r\n", len(s), s)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package proxy
+
+import (
+	"context"
+	"time"
+
+	"github.com/shanukun/cash/cluster"
+	"github.com/shanukun/cash/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// outgoing passes the metadata of a request on to the backends, such as
+// the quorums of Dynamo backends.
+func outgoing(ctx context.Context) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		return metadata.NewOutgoingContext(ctx, md.Copy())
+	}
+	return ctx
+}
+
+// owner returns the address of the backend of key: the owner of its slot
+// in ModeSlots, and the first healthy backend on the ring from it in
+// ModeHash. Keys of unassigned slots go to any backend, which redirects
+// them.
+func (p *Proxy) owner(key string) (string, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.cfg.Mode == ModeSlots {
+		if address := p.slots[cluster.KeySlot(key)]; address != "" {
+			return address, nil
+		}
+		return p.anyBackend()
+	}
+	for _, address := range p.ring.Nodes(key, len(p.cfg.Backends)) {
+		if p.backends[address].healthy {
+			return address, nil
+		}
+	}
+	return "", status.Error(codes.Unavailable, ErrNoBackend.Error())
+}
+
+// anyBackend returns a healthy backend, preferring the first configured.
+// Must be called with p.mu held.
+func (p *Proxy) anyBackend() (string, error) {
+	for _, address := range p.cfg.Backends {
+		if p.backends[address].healthy {
+			return address, nil
+		}
+	}
+	for address, b := range p.backends {
+		if b.healthy {
+			return address, nil
+		}
+	}
+	return "", status.Error(codes.Unavailable, ErrNoBackend.Error())
+}
+
+// route returns the backend of keys, which must all be on the same one,
+// or any backend if there are none.
+func (p *Proxy) route(keys []string) (string, error) {
+	if len(keys) == 0 {
+		p.mu.RLock()
+		defer p.mu.RUnlock()
+		return p.anyBackend()
+	}
+	var target string
+	for _, key := range keys {
+		address, err := p.owner(key)
+		if err != nil {
+			return "", err
+		}
+		if target != "" && address != target {
+			return "", status.Error(codes.InvalidArgument, "CROSSNODE Keys in request are not kept by the same backend")
+		}
+		target = address
+	}
+	return target, nil
+}
+
+// forward sends a request on keys to their backend. Requests are sent
+// again to the new owner of their keys when redirected. Reads are also
+// sent again after a backend failure, once the slot map is refreshed or on
+// the next backend of the ring; writes are not, as the backend may have
+// applied them before failing.
+func (p *Proxy) forward(ctx context.Context, name string, req proto.Message, keys []string) (proto.Message, error) {
+	address, err := p.route(keys)
+	if err != nil {
+		return nil, err
+	}
+	asking := false
+	for attempt := 0; ; attempt++ {
+		resp, err := p.call(ctx, address, name, req, asking)
+		if err == nil || attempt >= p.cfg.Retries {
+			return resp, err
+		}
+
+		asking = false
+		if r, ok := cluster.ParseRedirect(err); ok && r.Address != "" {
+			if r.Ask {
+				asking = true
+			} else {
+				p.mu.Lock()
+				p.slots[r.Slot] = r.Address
+				p.mu.Unlock()
+				go p.check()
+			}
+			address = r.Address
+			continue
+		}
+		if !isDown(err) {
+			return nil, err
+		}
+
+		p.setHealthy(address, false)
+		if !service.ReadOnly(name) {
+			return nil, err
+		}
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(time.Duration(attempt+1) * retryBackoff):
+		}
+		if p.cfg.Mode == ModeSlots {
+			p.check()
+		}
+		if address, err = p.route(keys); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// call sends a request to the backend at address.
+func (p *Proxy) call(ctx context.Context, address, name string, req proto.Message, asking bool) (proto.Message, error) {
+	b, err := p.backend(address)
+	if err != nil {
+		return nil, err
+	}
+	if asking {
+		ctx = cluster.Asking(ctx)
+	}
+	resp := p.methods[name].out.New().Interface()
+	if err := b.conn.Invoke(ctx, fullMethod(name), req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,87 @@
+package proxy
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	"github.com/shanukun/cash/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// testBackend is a cache served over gRPC, counting the requests it runs.
+type testBackend struct {
+	address string
+	mu      sync.Mutex
+	calls   map[string]int
+	// timeout makes the backend run requests but answer too late.
+	timeout bool
+}
+
+func newTestBackend(t *testing.T, timeout bool) *testBackend {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := &testBackend{address: lis.Addr().String(), calls: make(map[string]int), timeout: timeout}
+	s := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		b.mu.Lock()
+		b.calls[info.FullMethod]++
+		b.mu.Unlock()
+		resp, err := handler(ctx, req)
+		if b.timeout {
+			return nil, status.Error(codes.DeadlineExceeded, "context deadline exceeded")
+		}
+		return resp, err
+	}))
+	pb.RegisterCacheServiceServer(s, service.NewCacheService(time.Minute, time.Minute))
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	return b
+}
+
+func (b *testBackend) count(method string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.calls["/CacheService/"+method]
+}
+
+func TestForwardRetriesReadsOnly(t *testing.T) {
+	slow, healthy := newTestBackend(t, true), newTestBackend(t, false)
+	p, err := New(Config{Backends: []string{slow.address, healthy.address}, Mode: ModeHash})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Find a key of the slow backend.
+	var key string
+	for i := 0; key == ""; i++ {
+		k := string(rune('a' + i))
+		if address, _ := p.owner(k); address == slow.address {
+			key = k
+		}
+	}
+
+	ctx := context.Background()
+	_, err = p.forward(ctx, "Set", &pb.String{Key: key, Value: "v"}, []string{key})
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("Set failed with %v, want DeadlineExceeded", err)
+	}
+	if slow.count("Set") != 1 || healthy.count("Set") != 0 {
+		t.Errorf("Set ran %d and %d times, want once on the slow backend only", slow.count("Set"), healthy.count("Set"))
+	}
+
+	p.setHealthy(slow.address, true)
+	_, err = p.forward(ctx, "Get", &pb.Key{Key: key}, []string{key})
+	if status.Code(err) == codes.DeadlineExceeded {
+		t.Fatalf("Get was not retried: %v", err)
+	}
+	if slow.count("Get") != 1 || healthy.count("Get") != 1 {
+		t.Errorf("Get ran %d and %d times, want once on each backend", slow.count("Get"), healthy.count("Get"))
+	}
+}
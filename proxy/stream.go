@@ -0,0 +1,97 @@
+package proxy
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// streamHandler relays the streams of a method, such as Watch, from its
+// backends. Watches of a key are sent to its backend and those of a prefix
+// or pattern to every backend, whose events are merged; revisions are
+// those of each backend. Messages are published on every backend, so
+// subscriptions are sent to any one of them.
+func (p *Proxy) streamHandler(name string) func(interface{}, grpc.ServerStream) error {
+	return func(_ interface{}, stream grpc.ServerStream) error {
+		req := p.methods[name].in.New().Interface()
+		if err := stream.RecvMsg(req); err != nil {
+			return err
+		}
+
+		var addresses []string
+		if w, ok := req.(*pb.WatchRequest); ok && w.GetPrefix() == "" && w.GetPattern() == "" {
+			address, err := p.owner(w.GetKey())
+			if err != nil {
+				return err
+			}
+			addresses = []string{address}
+		} else if ok {
+			addresses = p.shards()
+		} else {
+			p.mu.RLock()
+			address, err := p.anyBackend()
+			p.mu.RUnlock()
+			if err != nil {
+				return err
+			}
+			addresses = []string{address}
+		}
+
+		ctx, cancel := context.WithCancel(outgoing(stream.Context()))
+		defer cancel()
+		var mu sync.Mutex
+		errc := make(chan error, len(addresses))
+		for _, address := range addresses {
+			go func(address string) {
+				errc <- p.relay(ctx, address, name, req, func(m proto.Message) error {
+					mu.Lock()
+					defer mu.Unlock()
+					return stream.SendMsg(m)
+				})
+			}(address)
+		}
+		for range addresses {
+			if err := <-errc; err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// relay sends the messages streamed by the backend at address for req to
+// send, until the backend ends the stream.
+func (p *Proxy) relay(ctx context.Context, address, name string, req proto.Message, send func(proto.Message) error) error {
+	b, err := p.backend(address)
+	if err != nil {
+		return err
+	}
+	cs, err := b.conn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, fullMethod(name))
+	if err != nil {
+		return err
+	}
+	if err := cs.SendMsg(req); err != nil {
+		return err
+	}
+	if err := cs.CloseSend(); err != nil {
+		return err
+	}
+	for {
+		m := p.methods[name].out.New().Interface()
+		if err := cs.RecvMsg(m); err == io.EOF {
+			return nil
+		} else if err != nil {
+			if isDown(err) {
+				p.setHealthy(address, false)
+			}
+			return err
+		}
+		if err := send(m); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,1166 @@
+// Package raft replicates a log of commands between a group of nodes with
+// the Raft consensus algorithm, so that every node applies the same
+// commands in the same order as long as a majority of the group is up.
+package raft
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/shanukun/cash/cash_proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrNotLeader      = errors.New("Node is not the leader")
+	ErrLeadershipLost = errors.New("Leadership was lost before the entry was committed")
+	ErrConfigChange   = errors.New("Another membership change is in progress")
+	ErrMemberExists   = errors.New("Member already exists")
+	ErrNoMember       = errors.New("Unknown member")
+	ErrSnapshotChunk  = errors.New("Snapshot chunk is out of order")
+	ErrUnreachable    = errors.New("Node is unreachable")
+	ErrStopped        = errors.New("Node is stopped")
+)
+
+const (
+	Follower  = "follower"
+	Candidate = "candidate"
+	Leader    = "leader"
+)
+
+const (
+	defaultElectionTimeout   = 300 * time.Millisecond
+	defaultHeartbeatInterval = 50 * time.Millisecond
+	defaultSnapshotThreshold = 1024
+
+	maxAppendEntries  = 64
+	snapshotChunkSize = 1 << 20
+)
+
+// StateMachine is the state replicated by a group.
+type StateMachine interface {
+	// Apply applies a committed command. local is set on the node which
+	// proposed it, in the term it proposed it.
+	Apply(data []byte, local bool) (interface{}, error)
+	// Snapshot returns the state after the commands applied so far. An
+	// error postpones the snapshot.
+	Snapshot() ([]byte, error)
+	// Restore replaces the state with a snapshot, nil being the empty
+	// state.
+	Restore(data []byte) error
+}
+
+type Config struct {
+	ID      string
+	Address string
+	// Members is the initial membership of the group. A node joining an
+	// existing group starts without members and learns them from the
+	// leader once added with AddMember.
+	Members []*pb.RaftMember
+
+	// ElectionTimeout is the time after which a follower which has not
+	// heard from the leader starts an election. Leaders hold a lease for
+	// most of it after hearing from a majority.
+	ElectionTimeout   time.Duration
+	HeartbeatInterval time.Duration
+	// SnapshotThreshold is the number of applied entries after which the
+	// log is compacted.
+	SnapshotThreshold int64
+
+	Storage   Storage
+	Transport Transport
+}
+
+// peer is the replication state of a follower as seen by the leader.
+type peer struct {
+	id      string
+	address string
+	next    int64
+	match   int64
+	// acked is when the last request answered by the peer was sent.
+	acked   time.Time
+	trigger chan struct{}
+	stop    chan struct{}
+}
+
+type result struct {
+	value interface{}
+	err   error
+}
+
+// proposal waits for the result of an entry appended by the leader.
+type proposal struct {
+	term int64
+	done chan result
+}
+
+// Node is a member of a Raft group. The log is kept in memory after the
+// last snapshot and persisted in Storage.
+type Node struct {
+	pb.UnimplementedRaftServiceServer
+
+	mu        sync.Mutex
+	cfg       Config
+	sm        StateMachine
+	storage   Storage
+	transport Transport
+
+	state    string
+	term     int64
+	vote     string
+	leader   string
+	contact  time.Time
+	deadline time.Time
+
+	log     []*pb.RaftEntry
+	snap    *Snapshot
+	commit  int64
+	applied int64
+	// restore is a snapshot for the applier to restore.
+	restore *Snapshot
+	// incoming holds the chunks of a snapshot being installed.
+	incoming []byte
+
+	members     map[string]string
+	configIndex int64
+
+	peers       map[string]*peer
+	leaderSince time.Time
+	leaderStart int64
+	proposals   map[int64]*proposal
+
+	changed chan struct{}
+	stopped chan struct{}
+}
+
+// New returns the node cfg.ID of a group, restoring its state from
+// cfg.Storage. It does nothing until started.
+func New(cfg Config, sm StateMachine) (*Node, error) {
+	if cfg.ElectionTimeout == 0 {
+		cfg.ElectionTimeout = defaultElectionTimeout
+	}
+	if cfg.HeartbeatInterval == 0 {
+		cfg.HeartbeatInterval = defaultHeartbeatInterval
+	}
+	if cfg.SnapshotThreshold == 0 {
+		cfg.SnapshotThreshold = defaultSnapshotThreshold
+	}
+	if cfg.Storage == nil {
+		cfg.Storage = NewMemoryStorage()
+	}
+	if cfg.Transport == nil {
+		cfg.Transport = NewGRPCTransport()
+	}
+
+	term, vote, snap, entries, err := cfg.Storage.Load()
+	if err != nil {
+		return nil, err
+	}
+	if snap == nil {
+		snap = &Snapshot{Config: &pb.RaftConfiguration{Members: cfg.Members}}
+	}
+	// Entries may survive a compaction interrupted by a crash.
+	for len(entries) > 0 && entries[0].Index <= snap.Index {
+		entries = entries[1:]
+	}
+
+	n := &Node{
+		cfg:       cfg,
+		sm:        sm,
+		storage:   cfg.Storage,
+		transport: cfg.Transport,
+		state:     Follower,
+		term:      term,
+		vote:      vote,
+		log:       entries,
+		snap:      snap,
+		commit:    snap.Index,
+		proposals: make(map[int64]*proposal),
+		changed:   make(chan struct{}),
+		stopped:   make(chan struct{}),
+	}
+	if snap.Index > 0 {
+		n.restore = snap
+	}
+	n.recomputeConfig()
+	n.resetDeadline()
+	return n, nil
+}
+
+// Start starts the timers of n and the application of committed entries.
+func (n *Node) Start() {
+	go n.run()
+	go n.applyLoop()
+}
+
+func (n *Node) Stop() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	select {
+	case <-n.stopped:
+		return
+	default:
+	}
+	close(n.stopped)
+	if n.state == Leader {
+		n.stopPeers()
+	}
+	n.state = Follower
+	n.failProposals(ErrStopped, 0)
+}
+
+// broadcast wakes up the goroutines waiting for a change of n. It must be
+// called with n.mu held.
+func (n *Node) broadcast() {
+	close(n.changed)
+	n.changed = make(chan struct{})
+}
+
+// wait waits for a change of n, returning with n.mu held again.
+func (n *Node) wait(ctx context.Context) error {
+	ch := n.changed
+	n.mu.Unlock()
+	defer n.mu.Lock()
+	select {
+	case <-ch:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-n.stopped:
+		return ErrStopped
+	}
+}
+
+func (n *Node) resetDeadline() {
+	t := n.cfg.ElectionTimeout
+	n.deadline = time.Now().Add(t + time.Duration(rand.Int63n(int64(t))))
+}
+
+// persistState saves the term and vote, which must not be forgotten once
+// other nodes were told about them.
+func (n *Node) persistState() {
+	if err := n.storage.SaveState(n.term, n.vote); err != nil {
+		panic(fmt.Sprintf("raft: saving state: %v", err))
+	}
+}
+
+func (n *Node) lastIndex() int64 {
+	return n.snap.Index + int64(len(n.log))
+}
+
+func (n *Node) lastTerm() int64 {
+	return n.termAt(n.lastIndex())
+}
+
+// termAt returns the term of the entry at index, or 0 if it is not known.
+func (n *Node) termAt(index int64) int64 {
+	if index == n.snap.Index {
+		return n.snap.Term
+	}
+	if index < n.snap.Index || index > n.lastIndex() {
+		return 0
+	}
+	return n.log[index-n.snap.Index-1].Term
+}
+
+// entries returns the entries from index from to index to inclusive.
+func (n *Node) entries(from, to int64) []*pb.RaftEntry {
+	if from > to {
+		return nil
+	}
+	return append([]*pb.RaftEntry(nil), n.log[from-n.snap.Index-1:to-n.snap.Index]...)
+}
+
+func (n *Node) quorum() int {
+	return len(n.members)/2 + 1
+}
+
+// quorumSince reports whether a majority of the members, counting the
+// leader itself, answered requests sent after t.
+func (n *Node) quorumSince(t time.Time) bool {
+	count := 0
+	if _, ok := n.members[n.cfg.ID]; ok {
+		count++
+	}
+	for id, p := range n.peers {
+		if _, ok := n.members[id]; ok && p.acked.After(t) {
+			count++
+		}
+	}
+	return count >= n.quorum()
+}
+
+// configAt returns the membership in effect at index. A configuration
+// takes effect as soon as it is appended to the log.
+func (n *Node) configAt(index int64) *pb.RaftConfiguration {
+	for i := len(n.log) - 1; i >= 0; i-- {
+		e := n.log[i]
+		if e.Index <= index && e.Type == pb.RaftEntryType_RAFT_CONFIG {
+			config := &pb.RaftConfiguration{}
+			if err := proto.Unmarshal(e.Data, config); err == nil {
+				return config
+			}
+		}
+	}
+	return n.snap.Config
+}
+
+// recomputeConfig updates the members after the configuration entries of
+// the log changed, starting and stopping the replication to them on the
+// leader.
+func (n *Node) recomputeConfig() {
+	n.configIndex = n.snap.Index
+	for i := len(n.log) - 1; i >= 0; i-- {
+		if n.log[i].Type == pb.RaftEntryType_RAFT_CONFIG {
+			n.configIndex = n.log[i].Index
+			break
+		}
+	}
+	n.members = make(map[string]string)
+	for _, m := range n.configAt(n.lastIndex()).GetMembers() {
+		n.members[m.Id] = m.Address
+	}
+
+	if n.state != Leader {
+		return
+	}
+	for id, p := range n.peers {
+		if _, ok := n.members[id]; !ok {
+			close(p.stop)
+			delete(n.peers, id)
+		}
+	}
+	for id, address := range n.members {
+		if _, ok := n.peers[id]; !ok && id != n.cfg.ID {
+			n.addPeer(id, address)
+		}
+	}
+}
+
+func (n *Node) run() {
+	ticker := time.NewTicker(n.cfg.HeartbeatInterval / 5)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-n.stopped:
+			return
+		case <-ticker.C:
+			n.tick()
+		}
+	}
+}
+
+func (n *Node) tick() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	now := time.Now()
+	if n.state == Leader {
+		// A leader cut from the majority steps down, so that clients
+		// look for the new one.
+		since := now.Add(-n.cfg.ElectionTimeout)
+		if n.leaderSince.Before(since) && !n.quorumSince(since) {
+			n.becomeFollower(n.term)
+		}
+		return
+	}
+	if now.After(n.deadline) {
+		n.campaign()
+	}
+}
+
+func (n *Node) campaign() {
+	n.resetDeadline()
+	if _, ok := n.members[n.cfg.ID]; !ok {
+		return
+	}
+	n.state = Candidate
+	n.term++
+	n.vote = n.cfg.ID
+	n.leader = ""
+	n.persistState()
+
+	votes := 1
+	if votes >= n.quorum() {
+		n.becomeLeader()
+		return
+	}
+	term := n.term
+	req := &pb.VoteRequest{
+		Term:         term,
+		Candidate:    n.cfg.ID,
+		LastLogIndex: n.lastIndex(),
+		LastLogTerm:  n.lastTerm(),
+	}
+	for id, address := range n.members {
+		if id == n.cfg.ID {
+			continue
+		}
+		go func(address string) {
+			ctx, cancel := context.WithTimeout(context.Background(), n.cfg.ElectionTimeout)
+			resp, err := n.transport.RequestVote(ctx, address, req)
+			cancel()
+			if err != nil {
+				return
+			}
+			n.mu.Lock()
+			defer n.mu.Unlock()
+			if resp.Term > n.term {
+				n.becomeFollower(resp.Term)
+				return
+			}
+			if n.state != Candidate || n.term != term || !resp.Granted {
+				return
+			}
+			votes++
+			if votes >= n.quorum() {
+				n.becomeLeader()
+			}
+		}(address)
+	}
+}
+
+// becomeFollower moves n to term if it is newer and makes it a follower.
+func (n *Node) becomeFollower(term int64) {
+	if term > n.term {
+		n.term = term
+		n.vote = ""
+		n.persistState()
+	}
+	if n.state == Leader {
+		n.stopPeers()
+		n.failProposals(ErrLeadershipLost, n.commit)
+		n.leader = ""
+	}
+	n.state = Follower
+	n.resetDeadline()
+	n.broadcast()
+}
+
+// becomeLeader starts the replication to the other members and appends
+// an empty entry, whose commit commits the entries of previous terms.
+func (n *Node) becomeLeader() {
+	n.state = Leader
+	n.leader = n.cfg.ID
+	n.leaderSince = time.Now()
+	n.peers = make(map[string]*peer)
+	for id, address := range n.members {
+		if id != n.cfg.ID {
+			n.addPeer(id, address)
+		}
+	}
+	n.leaderStart = n.appendEntry(pb.RaftEntryType_RAFT_NOOP, nil).Index
+	n.advanceCommit()
+	n.broadcast()
+}
+
+func (n *Node) addPeer(id, address string) {
+	p := &peer{
+		id:      id,
+		address: address,
+		next:    n.lastIndex() + 1,
+		trigger: make(chan struct{}, 1),
+		stop:    make(chan struct{}),
+	}
+	n.peers[id] = p
+	go n.replicateLoop(p, n.term)
+}
+
+func (n *Node) stopPeers() {
+	for _, p := range n.peers {
+		close(p.stop)
+	}
+	n.peers = nil
+}
+
+func (n *Node) triggerPeers() {
+	for _, p := range n.peers {
+		select {
+		case p.trigger <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// failProposals fails the proposals of the entries after index. The
+// others are committed and get their result once applied.
+func (n *Node) failProposals(err error, index int64) {
+	for i, p := range n.proposals {
+		if i > index {
+			p.done <- result{err: err}
+			delete(n.proposals, i)
+		}
+	}
+}
+
+// appendEntry appends an entry of the current term to the log of the
+// leader and triggers its replication.
+func (n *Node) appendEntry(t pb.RaftEntryType, data []byte) *pb.RaftEntry {
+	e := &pb.RaftEntry{
+		Term:  n.term,
+		Index: n.lastIndex() + 1,
+		Type:  t,
+		Data:  data,
+	}
+	n.log = append(n.log, e)
+	if err := n.storage.Append([]*pb.RaftEntry{e}); err != nil {
+		panic(fmt.Sprintf("raft: appending entry: %v", err))
+	}
+	if t == pb.RaftEntryType_RAFT_CONFIG {
+		n.recomputeConfig()
+	}
+	n.triggerPeers()
+	return e
+}
+
+// advanceCommit commits the last entry of the current term stored by a
+// majority, along with the entries before it.
+func (n *Node) advanceCommit() {
+	for i := n.lastIndex(); i > n.commit && n.termAt(i) == n.term; i-- {
+		count := 0
+		if _, ok := n.members[n.cfg.ID]; ok {
+			count++
+		}
+		for id, p := range n.peers {
+			if _, ok := n.members[id]; ok && p.match >= i {
+				count++
+			}
+		}
+		if count < n.quorum() {
+			continue
+		}
+		n.commit = i
+		n.broadcast()
+		// A leader removed from the group leaves once its removal is
+		// committed.
+		if _, ok := n.members[n.cfg.ID]; !ok && n.configIndex <= n.commit {
+			n.becomeFollower(n.term)
+		}
+		return
+	}
+}
+
+func (n *Node) replicateLoop(p *peer, term int64) {
+	ticker := time.NewTicker(n.cfg.HeartbeatInterval)
+	defer ticker.Stop()
+	for {
+		for n.replicate(p, term) {
+		}
+		select {
+		case <-p.stop:
+			return
+		case <-n.stopped:
+			return
+		case <-p.trigger:
+		case <-ticker.C:
+		}
+	}
+}
+
+// replicate sends the entries p is missing, or a heartbeat if it has them
+// all. It reports whether more should be sent right away.
+func (n *Node) replicate(p *peer, term int64) bool {
+	n.mu.Lock()
+	if n.state != Leader || n.term != term || n.peers[p.id] != p {
+		n.mu.Unlock()
+		return false
+	}
+	if p.next <= n.snap.Index {
+		snap := n.snap
+		n.mu.Unlock()
+		return n.sendSnapshot(p, term, snap)
+	}
+	prev := p.next - 1
+	last := n.lastIndex()
+	if last > prev+maxAppendEntries {
+		last = prev + maxAppendEntries
+	}
+	req := &pb.AppendRequest{
+		Term:         term,
+		Leader:       n.cfg.ID,
+		PrevLogIndex: prev,
+		PrevLogTerm:  n.termAt(prev),
+		Entries:      n.entries(prev+1, last),
+		Commit:       n.commit,
+	}
+	n.mu.Unlock()
+
+	sent := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), n.cfg.ElectionTimeout)
+	resp, err := n.transport.AppendEntries(ctx, p.address, req)
+	cancel()
+	if err != nil {
+		return false
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if resp.Term > n.term {
+		n.becomeFollower(resp.Term)
+		return false
+	}
+	if n.state != Leader || n.term != term {
+		return false
+	}
+	if sent.After(p.acked) {
+		p.acked = sent
+	}
+	defer n.broadcast()
+	if !resp.Success {
+		// The follower hints where its log stops matching.
+		p.next = resp.NextIndex
+		if p.next > prev {
+			p.next = prev
+		}
+		if p.next < 1 {
+			p.next = 1
+		}
+		return true
+	}
+	if match := prev + int64(len(req.Entries)); match > p.match {
+		p.match = match
+	}
+	p.next = p.match + 1
+	n.advanceCommit()
+	return p.next <= n.lastIndex()
+}
+
+// sendSnapshot sends snap to p in chunks, for p to catch up with entries
+// the leader no longer has.
+func (n *Node) sendSnapshot(p *peer, term int64, snap *Snapshot) bool {
+	for offset := 0; ; {
+		end := offset + snapshotChunkSize
+		if end > len(snap.Data) {
+			end = len(snap.Data)
+		}
+		req := &pb.SnapshotRequest{
+			Term:      term,
+			Leader:    n.cfg.ID,
+			LastIndex: snap.Index,
+			LastTerm:  snap.Term,
+			Config:    snap.Config,
+			Offset:    int64(offset),
+			Data:      snap.Data[offset:end],
+			Done:      end == len(snap.Data),
+		}
+		sent := time.Now()
+		ctx, cancel := context.WithTimeout(context.Background(), n.cfg.ElectionTimeout)
+		resp, err := n.transport.InstallSnapshot(ctx, p.address, req)
+		cancel()
+		if err != nil {
+			return false
+		}
+
+		n.mu.Lock()
+		if resp.Term > n.term {
+			n.becomeFollower(resp.Term)
+			n.mu.Unlock()
+			return false
+		}
+		if n.state != Leader || n.term != term {
+			n.mu.Unlock()
+			return false
+		}
+		if sent.After(p.acked) {
+			p.acked = sent
+		}
+		if req.Done {
+			if snap.Index > p.match {
+				p.match = snap.Index
+			}
+			p.next = p.match + 1
+			n.advanceCommit()
+			n.broadcast()
+			n.mu.Unlock()
+			return true
+		}
+		n.mu.Unlock()
+		offset = end
+	}
+}
+
+// applyLoop applies the committed entries to the state machine and
+// restores the snapshots installed by the leader.
+func (n *Node) applyLoop() {
+	for {
+		n.mu.Lock()
+		for n.restore == nil && n.applied >= n.commit {
+			if n.wait(context.Background()) != nil {
+				n.mu.Unlock()
+				return
+			}
+		}
+		if snap := n.restore; snap != nil {
+			n.restore = nil
+			n.mu.Unlock()
+			if err := n.sm.Restore(snap.Data); err != nil {
+				panic(fmt.Sprintf("raft: restoring snapshot: %v", err))
+			}
+			n.mu.Lock()
+			n.applied = snap.Index
+			n.broadcast()
+			n.mu.Unlock()
+			continue
+		}
+		entries := n.entries(n.applied+1, n.commit)
+		n.mu.Unlock()
+
+		for _, e := range entries {
+			n.mu.Lock()
+			if n.restore != nil {
+				n.mu.Unlock()
+				break
+			}
+			p := n.proposals[e.Index]
+			delete(n.proposals, e.Index)
+			n.mu.Unlock()
+
+			var res result
+			if e.Type == pb.RaftEntryType_RAFT_COMMAND {
+				res.value, res.err = n.sm.Apply(e.Data, p != nil && p.term == e.Term)
+			}
+			if p != nil {
+				if p.term != e.Term {
+					res = result{err: ErrLeadershipLost}
+				}
+				p.done <- res
+			}
+
+			n.mu.Lock()
+			if n.restore == nil && n.applied < e.Index {
+				n.applied = e.Index
+			}
+			n.broadcast()
+			n.mu.Unlock()
+		}
+		n.maybeSnapshot()
+	}
+}
+
+// maybeSnapshot compacts the log once enough entries were applied since
+// the last snapshot. It runs on the applier, so the state machine is at
+// the applied index.
+func (n *Node) maybeSnapshot() {
+	n.mu.Lock()
+	applied := n.applied
+	due := n.restore == nil && applied-n.snap.Index >= n.cfg.SnapshotThreshold
+	n.mu.Unlock()
+	if !due {
+		return
+	}
+	data, err := n.sm.Snapshot()
+	if err != nil {
+		return
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if applied <= n.snap.Index {
+		return
+	}
+	snap := &Snapshot{
+		Index:  applied,
+		Term:   n.termAt(applied),
+		Config: n.configAt(applied),
+		Data:   data,
+	}
+	n.log = append([]*pb.RaftEntry(nil), n.log[applied-n.snap.Index:]...)
+	n.snap = snap
+	n.saveSnapshot()
+}
+
+func (n *Node) saveSnapshot() {
+	if err := n.storage.SaveSnapshot(n.snap); err != nil {
+		panic(fmt.Sprintf("raft: saving snapshot: %v", err))
+	}
+	if err := n.storage.Rewrite(n.log); err != nil {
+		panic(fmt.Sprintf("raft: rewriting log: %v", err))
+	}
+}
+
+// Propose appends a command to the log and returns the result of applying
+// it once committed. An error means the command may or may not be
+// committed later.
+func (n *Node) Propose(ctx context.Context, data []byte) (interface{}, error) {
+	n.mu.Lock()
+	if n.state != Leader {
+		n.mu.Unlock()
+		return nil, ErrNotLeader
+	}
+	index, p := n.propose(pb.RaftEntryType_RAFT_COMMAND, data)
+	n.mu.Unlock()
+	return n.await(ctx, index, p)
+}
+
+func (n *Node) propose(t pb.RaftEntryType, data []byte) (int64, *proposal) {
+	e := n.appendEntry(t, data)
+	p := &proposal{term: n.term, done: make(chan result, 1)}
+	n.proposals[e.Index] = p
+	n.advanceCommit()
+	return e.Index, p
+}
+
+func (n *Node) await(ctx context.Context, index int64, p *proposal) (interface{}, error) {
+	select {
+	case res := <-p.done:
+		return res.value, res.err
+	case <-ctx.Done():
+		n.mu.Lock()
+		delete(n.proposals, index)
+		n.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+// ReadIndex waits until the state machine of the leader reflects every
+// entry committed before the call, so that reading it is linearizable.
+// The leader confirms it still leads with a round of heartbeats, unless
+// a majority answered recently enough for no other leader to be elected.
+func (n *Node) ReadIndex(ctx context.Context) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	term := n.term
+	for n.state == Leader && n.term == term && n.commit < n.leaderStart {
+		if err := n.wait(ctx); err != nil {
+			return err
+		}
+	}
+	if n.state != Leader || n.term != term {
+		return ErrNotLeader
+	}
+	index := n.commit
+
+	lease := n.cfg.ElectionTimeout * 9 / 10
+	if !n.quorumSince(time.Now().Add(-lease)) {
+		start := time.Now()
+		n.triggerPeers()
+		for !n.quorumSince(start) {
+			if err := n.wait(ctx); err != nil {
+				return err
+			}
+			if n.state != Leader || n.term != term {
+				return ErrNotLeader
+			}
+		}
+	}
+	for n.applied < index {
+		if err := n.wait(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Resync makes the applier restore the last snapshot and apply the
+// committed entries again, for the state machine to drop changes which
+// were not committed.
+func (n *Node) Resync() {
+	n.mu.Lock()
+	n.restore = n.snap
+	n.applied = n.snap.Index
+	n.broadcast()
+	n.mu.Unlock()
+}
+
+// AddMember adds a member to the group, returning once the change is
+// committed. Members are changed one at a time.
+func (n *Node) AddMember(ctx context.Context, id, address string) error {
+	return n.changeConfig(ctx, func(members map[string]string) error {
+		if _, ok := members[id]; ok {
+			return ErrMemberExists
+		}
+		members[id] = address
+		return nil
+	})
+}
+
+func (n *Node) RemoveMember(ctx context.Context, id string) error {
+	return n.changeConfig(ctx, func(members map[string]string) error {
+		if _, ok := members[id]; !ok {
+			return ErrNoMember
+		}
+		delete(members, id)
+		return nil
+	})
+}
+
+func (n *Node) changeConfig(ctx context.Context, fn func(members map[string]string) error) error {
+	n.mu.Lock()
+	if n.state != Leader {
+		n.mu.Unlock()
+		return ErrNotLeader
+	}
+	// A change waits for the previous one, and for an entry of the term
+	// of the leader to be committed.
+	if n.configIndex > n.commit || n.leaderStart > n.commit {
+		n.mu.Unlock()
+		return ErrConfigChange
+	}
+	members := make(map[string]string)
+	for id, address := range n.members {
+		members[id] = address
+	}
+	if err := fn(members); err != nil {
+		n.mu.Unlock()
+		return err
+	}
+	data, err := proto.Marshal(configuration(members))
+	if err != nil {
+		n.mu.Unlock()
+		return err
+	}
+	index, p := n.propose(pb.RaftEntryType_RAFT_CONFIG, data)
+	n.mu.Unlock()
+	_, err = n.await(ctx, index, p)
+	return err
+}
+
+func configuration(members map[string]string) *pb.RaftConfiguration {
+	config := &pb.RaftConfiguration{}
+	for id, address := range members {
+		config.Members = append(config.Members, &pb.RaftMember{Id: id, Address: address})
+	}
+	sort.Slice(config.Members, func(i, j int) bool {
+		return config.Members[i].Id < config.Members[j].Id
+	})
+	return config
+}
+
+// Leader returns the id and address of the leader known to n, or empty
+// strings if there is none.
+func (n *Node) Leader() (string, string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.leader, n.members[n.leader]
+}
+
+func (n *Node) IsLeader() bool {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.state == Leader
+}
+
+// NotLeaderError returns the error telling clients to send requests to
+// the leader. Besides its message, the error carries a pb.Redirect detail
+// with the leader, if known.
+func (n *Node) NotLeaderError() error {
+	id, address := n.Leader()
+	st := status.New(codes.FailedPrecondition, fmt.Sprintf("NOTLEADER %s", address))
+	st, err := st.WithDetails(&pb.Redirect{
+		Node:    id,
+		Address: address,
+	})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return st.Err()
+}
+
+func (n *Node) State() *pb.RaftState {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return &pb.RaftState{
+		Id:            n.cfg.ID,
+		State:         n.state,
+		Term:          n.term,
+		Leader:        n.leader,
+		LeaderAddress: n.members[n.leader],
+		CommitIndex:   n.commit,
+		AppliedIndex:  n.applied,
+		LastIndex:     n.lastIndex(),
+		SnapshotIndex: n.snap.Index,
+		Members:       configuration(n.members).Members,
+	}
+}
+
+func (n *Node) RequestVote(ctx context.Context, req *pb.VoteRequest) (*pb.VoteResponse, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	resp := &pb.VoteResponse{Term: n.term}
+	if req.Term < n.term {
+		return resp, nil
+	}
+	// Candidates are ignored while the leader is heard from, so that
+	// removed or partitioned members do not depose it and the lease of
+	// the leader holds.
+	if n.state == Leader || (n.leader != "" && time.Since(n.contact) < n.cfg.ElectionTimeout) {
+		return resp, nil
+	}
+	if req.Term > n.term {
+		n.becomeFollower(req.Term)
+		resp.Term = n.term
+	}
+	upToDate := req.LastLogTerm > n.lastTerm() ||
+		(req.LastLogTerm == n.lastTerm() && req.LastLogIndex >= n.lastIndex())
+	if (n.vote == "" || n.vote == req.Candidate) && upToDate {
+		n.vote = req.Candidate
+		n.persistState()
+		n.resetDeadline()
+		resp.Granted = true
+	}
+	return resp, nil
+}
+
+// follow makes n follow the sender of a request of the current term.
+func (n *Node) follow(term int64, leader string) {
+	if term > n.term || n.state != Follower {
+		n.becomeFollower(term)
+	} else {
+		n.resetDeadline()
+	}
+	n.leader = leader
+	n.contact = time.Now()
+}
+
+func (n *Node) AppendEntries(ctx context.Context, req *pb.AppendRequest) (*pb.AppendResponse, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	resp := &pb.AppendResponse{Term: n.term}
+	if req.Term < n.term {
+		return resp, nil
+	}
+	n.follow(req.Term, req.Leader)
+	resp.Term = n.term
+
+	if req.PrevLogIndex > n.lastIndex() {
+		resp.NextIndex = n.lastIndex() + 1
+		return resp, nil
+	}
+	if req.PrevLogIndex >= n.snap.Index {
+		if t := n.termAt(req.PrevLogIndex); t != req.PrevLogTerm {
+			// Skip the whole conflicting term rather than one entry.
+			i := req.PrevLogIndex
+			for i-1 > n.snap.Index && n.termAt(i-1) == t {
+				i--
+			}
+			resp.NextIndex = i
+			return resp, nil
+		}
+	}
+
+	var appended []*pb.RaftEntry
+	truncated, config := false, false
+	for _, e := range req.Entries {
+		if e.Index <= n.snap.Index {
+			continue
+		}
+		if e.Index <= n.lastIndex() {
+			if n.termAt(e.Index) == e.Term {
+				continue
+			}
+			n.log = n.log[:e.Index-n.snap.Index-1]
+			truncated = true
+		}
+		n.log = append(n.log, e)
+		appended = append(appended, e)
+		config = config || e.Type == pb.RaftEntryType_RAFT_CONFIG
+	}
+	var err error
+	if truncated {
+		err = n.storage.Rewrite(n.log)
+	} else if len(appended) > 0 {
+		err = n.storage.Append(appended)
+	}
+	if err != nil {
+		panic(fmt.Sprintf("raft: appending entries: %v", err))
+	}
+	if truncated || config {
+		n.recomputeConfig()
+	}
+
+	commit := req.Commit
+	if last := req.PrevLogIndex + int64(len(req.Entries)); commit > last {
+		commit = last
+	}
+	if commit > n.commit {
+		n.commit = commit
+		n.broadcast()
+	}
+	resp.Success = true
+	resp.NextIndex = n.lastIndex() + 1
+	return resp, nil
+}
+
+func (n *Node) InstallSnapshot(ctx context.Context, req *pb.SnapshotRequest) (*pb.SnapshotResponse, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	resp := &pb.SnapshotResponse{Term: n.term}
+	if req.Term < n.term {
+		return resp, nil
+	}
+	n.follow(req.Term, req.Leader)
+	resp.Term = n.term
+
+	if req.Offset == 0 {
+		n.incoming = nil
+	}
+	if req.Offset != int64(len(n.incoming)) {
+		return nil, ErrSnapshotChunk
+	}
+	n.incoming = append(n.incoming, req.Data...)
+	if !req.Done {
+		return resp, nil
+	}
+	data := n.incoming
+	n.incoming = nil
+	if req.LastIndex <= n.snap.Index {
+		return resp, nil
+	}
+
+	// Entries following the snapshot are kept if the log matches it.
+	restore := true
+	if req.LastIndex < n.lastIndex() && n.termAt(req.LastIndex) == req.LastTerm {
+		n.log = append([]*pb.RaftEntry(nil), n.log[req.LastIndex-n.snap.Index:]...)
+		restore = n.applied < req.LastIndex
+	} else {
+		n.log = nil
+	}
+	n.snap = &Snapshot{
+		Index:  req.LastIndex,
+		Term:   req.LastTerm,
+		Config: req.Config,
+		Data:   data,
+	}
+	n.saveSnapshot()
+	if restore {
+		n.restore = n.snap
+	}
+	if n.commit < req.LastIndex {
+		n.commit = req.LastIndex
+	}
+	n.recomputeConfig()
+	n.broadcast()
+	return resp, nil
+}
+
+func (n *Node) RaftStatus(ctx context.Context, _ *empty.Empty) (*pb.RaftState, error) {
+	return n.State(), nil
+}
+
+func (n *Node) RaftAddMember(ctx context.Context, req *pb.RaftMember) (*pb.RaftState, error) {
+	if err := n.AddMember(ctx, req.Id, req.Address); err != nil {
+		return nil, n.statusError(err)
+	}
+	return n.State(), nil
+}
+
+func (n *Node) RaftRemoveMember(ctx context.Context, req *pb.RaftMember) (*pb.RaftState, error) {
+	if err := n.RemoveMember(ctx, req.Id); err != nil {
+		return nil, n.statusError(err)
+	}
+	return n.State(), nil
+}
+
+func (n *Node) statusError(err error) error {
+	if err == ErrNotLeader {
+		return n.NotLeaderError()
+	}
+	return err
+}
@@ -0,0 +1,240 @@
+package raft
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	"google.golang.org/protobuf/proto"
+)
+
+// Snapshot is the state of the state machine after applying the entries
+// up to Index, along with the membership at that point.
+type Snapshot struct {
+	Index  int64
+	Term   int64
+	Config *pb.RaftConfiguration
+	Data   []byte
+}
+
+// Storage persists the term, vote, log and latest snapshot of a node, so
+// that it can restart without breaking the promises it made to others.
+type Storage interface {
+	Load() (term int64, vote string, snap *Snapshot, entries []*pb.RaftEntry, err error)
+	SaveState(term int64, vote string) error
+	Append(entries []*pb.RaftEntry) error
+	// Rewrite replaces the log with entries, after it was truncated or
+	// compacted.
+	Rewrite(entries []*pb.RaftEntry) error
+	SaveSnapshot(snap *Snapshot) error
+}
+
+// MemoryStorage keeps everything in memory. It outlives the nodes using it,
+// so in-process groups can restart nodes.
+type MemoryStorage struct {
+	mu      sync.Mutex
+	term    int64
+	vote    string
+	snap    *Snapshot
+	entries []*pb.RaftEntry
+}
+
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{}
+}
+
+func (s *MemoryStorage) Load() (int64, string, *Snapshot, []*pb.RaftEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.term, s.vote, s.snap, append([]*pb.RaftEntry(nil), s.entries...), nil
+}
+
+func (s *MemoryStorage) SaveState(term int64, vote string) error {
+	s.mu.Lock()
+	s.term, s.vote = term, vote
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *MemoryStorage) Append(entries []*pb.RaftEntry) error {
+	s.mu.Lock()
+	s.entries = append(s.entries, entries...)
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *MemoryStorage) Rewrite(entries []*pb.RaftEntry) error {
+	s.mu.Lock()
+	s.entries = append([]*pb.RaftEntry(nil), entries...)
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *MemoryStorage) SaveSnapshot(snap *Snapshot) error {
+	s.mu.Lock()
+	s.snap = snap
+	s.mu.Unlock()
+	return nil
+}
+
+// FileStorage keeps the state of a node in a directory: the term and vote
+// in "state", the log in "log" as length prefixed entries and the latest
+// snapshot in "snapshot". Files are replaced by renaming, so a crash
+// leaves either the old or the new version.
+type FileStorage struct {
+	dir string
+	log *os.File
+}
+
+func NewFileStorage(dir string) (*FileStorage, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	log, err := os.OpenFile(filepath.Join(dir, "log"), os.O_CREATE|os.O_RDWR|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &FileStorage{dir: dir, log: log}, nil
+}
+
+func (s *FileStorage) path(name string) string {
+	return filepath.Join(s.dir, name)
+}
+
+func (s *FileStorage) writeFile(name string, data []byte) error {
+	tmp := s.path(name + ".tmp")
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, s.path(name))
+}
+
+func (s *FileStorage) Load() (int64, string, *Snapshot, []*pb.RaftEntry, error) {
+	var term int64
+	var vote string
+	state, err := os.ReadFile(s.path("state"))
+	if err == nil {
+		t, v, _ := strings.Cut(string(state), "\n")
+		if term, err = strconv.ParseInt(t, 10, 64); err != nil {
+			return 0, "", nil, nil, err
+		}
+		vote = v
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return 0, "", nil, nil, err
+	}
+
+	var snap *Snapshot
+	data, err := os.ReadFile(s.path("snapshot"))
+	if err == nil {
+		req := &pb.SnapshotRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			return 0, "", nil, nil, err
+		}
+		snap = &Snapshot{Index: req.LastIndex, Term: req.LastTerm, Config: req.Config, Data: req.Data}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return 0, "", nil, nil, err
+	}
+
+	var entries []*pb.RaftEntry
+	if _, err := s.log.Seek(0, io.SeekStart); err != nil {
+		return 0, "", nil, nil, err
+	}
+	r := bufio.NewReader(s.log)
+	for {
+		size, err := binary.ReadUvarint(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, "", nil, nil, err
+		}
+		buf := make([]byte, size)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			// The last entry was not fully written before a crash.
+			break
+		}
+		e := &pb.RaftEntry{}
+		if err := proto.Unmarshal(buf, e); err != nil {
+			return 0, "", nil, nil, err
+		}
+		entries = append(entries, e)
+	}
+	return term, vote, snap, entries, nil
+}
+
+func (s *FileStorage) SaveState(term int64, vote string) error {
+	return s.writeFile("state", []byte(strconv.FormatInt(term, 10)+"\n"+vote))
+}
+
+func encodeEntries(entries []*pb.RaftEntry) ([]byte, error) {
+	var buf []byte
+	for _, e := range entries {
+		data, err := proto.Marshal(e)
+		if err != nil {
+			return nil, err
+		}
+		buf = binary.AppendUvarint(buf, uint64(len(data)))
+		buf = append(buf, data...)
+	}
+	return buf, nil
+}
+
+func (s *FileStorage) Append(entries []*pb.RaftEntry) error {
+	buf, err := encodeEntries(entries)
+	if err != nil {
+		return err
+	}
+	if _, err := s.log.Write(buf); err != nil {
+		return err
+	}
+	return s.log.Sync()
+}
+
+func (s *FileStorage) Rewrite(entries []*pb.RaftEntry) error {
+	buf, err := encodeEntries(entries)
+	if err != nil {
+		return err
+	}
+	if err := s.writeFile("log", buf); err != nil {
+		return err
+	}
+	log, err := os.OpenFile(s.path("log"), os.O_RDWR|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	s.log.Close()
+	s.log = log
+	return nil
+}
+
+func (s *FileStorage) SaveSnapshot(snap *Snapshot) error {
+	data, err := proto.Marshal(&pb.SnapshotRequest{
+		LastIndex: snap.Index,
+		LastTerm:  snap.Term,
+		Config:    snap.Config,
+		Data:      snap.Data,
+	})
+	if err != nil {
+		return err
+	}
+	return s.writeFile("snapshot", data)
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	"github.com/shanukun/cash/sentinel"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// runSentinel runs the sentinel command, which monitors a primary and fails
+// over to one of its replicas.
+func runSentinel(args []string) {
+	fs := flag.NewFlagSet("sentinel", flag.ExitOnError)
+	addr := fs.String("addr", ":26379", "address")
+	adv := fs.String("advertise", "", "address advertised to other sentinels (default addr)")
+	primary := fs.String("primary", "", "address of the monitored primary")
+	peers := fs.String("sentinels", "", "addresses of the other sentinels, such as host1:26379,host2:26379")
+	quorum := fs.Int("quorum", 0, "sentinels agreeing the primary is down before a failover (default majority)")
+	downAfter := fs.Duration("down-after", 5*time.Second, "time without answer after which a node is down")
+	fs.Parse(args)
+
+	if *primary == "" {
+		log.Fatalf("sentinel needs the address of the primary")
+	}
+	if *adv == "" {
+		*adv = *addr
+	}
+	var peerList []string
+	for _, p := range strings.Split(*peers, ",") {
+		if p != "" {
+			peerList = append(peerList, p)
+		}
+	}
+
+	s := sentinel.New(sentinel.Config{
+		ID:        *adv,
+		Primary:   *primary,
+		Peers:     peerList,
+		Quorum:    *quorum,
+		DownAfter: *downAfter,
+	})
+	grpcServer := grpc.NewServer()
+	pb.RegisterSentinelServiceServer(grpcServer, s)
+	reflection.Register(grpcServer)
+	s.Start()
+
+	lis, err := net.Listen("tcp", *addr)
+	if err != nil {
+		log.Fatalf("start error %v", err)
+	}
+	fmt.Println("sentinel running on:", *addr)
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("grpc server failed: %v\n", err)
+	}
+}
@@ -0,0 +1,443 @@
+// Package sentinel fails over a primary to one of its replicas. Sentinels
+// monitor the primary and its replicas, and when the primary stops
+// answering, the sentinels which agree that it is down elect one of them
+// to promote the most up-to-date replica and repoint the others. Every
+// failover has a higher epoch than the configuration it replaces, so that
+// nodes and sentinels ignore older ones.
+package sentinel
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/shanukun/cash/cash_proto"
+)
+
+var (
+	ErrNoReplica  = errors.New("No replica can be promoted")
+	ErrStaleEpoch = errors.New("Failover is older than the current configuration")
+)
+
+// Roles reported by nodes.
+const (
+	rolePrimary = "primary"
+	roleReplica = "replica"
+)
+
+const (
+	defaultCheckInterval = time.Second
+	defaultDownAfter     = 5 * time.Second
+)
+
+type Config struct {
+	ID string
+	// Primary is the address of the primary when the sentinel starts.
+	Primary string
+	// Peers are the addresses of the other sentinels.
+	Peers []string
+	// Quorum is the number of sentinels which must agree that the primary
+	// is down, itself included, before a failover. It defaults to a
+	// majority of the sentinels, which is also needed to elect the
+	// sentinel running the failover.
+	Quorum int
+	// CheckInterval is the time between two checks of the nodes.
+	CheckInterval time.Duration
+	// DownAfter is the time after which a node which does not answer is
+	// considered down.
+	DownAfter time.Duration
+
+	Transport Transport
+}
+
+type node struct {
+	state *pb.ReplicationState
+	// seen is when the node last answered.
+	seen time.Time
+}
+
+// Sentinel is the view of a primary and its replicas held by a sentinel.
+type Sentinel struct {
+	pb.UnimplementedSentinelServiceServer
+
+	mu      sync.Mutex
+	cfg     Config
+	primary string
+	// epoch is the epoch of the current configuration, and currentEpoch
+	// the highest epoch of a failover seen so far.
+	epoch        int64
+	currentEpoch int64
+	votedEpoch   int64
+	votedFor     string
+	nodes        map[string]*node
+	// nextFailover is the earliest time to start a failover, leaving time
+	// to the failovers of other sentinels.
+	nextFailover time.Time
+	stopped      chan struct{}
+}
+
+// New returns the sentinel cfg.ID monitoring cfg.Primary, to be started
+// with Start.
+func New(cfg Config) *Sentinel {
+	if cfg.Quorum == 0 {
+		cfg.Quorum = (len(cfg.Peers)+1)/2 + 1
+	}
+	if cfg.CheckInterval == 0 {
+		cfg.CheckInterval = defaultCheckInterval
+	}
+	if cfg.DownAfter == 0 {
+		cfg.DownAfter = defaultDownAfter
+	}
+	if cfg.Transport == nil {
+		cfg.Transport = NewGRPCTransport()
+	}
+	return &Sentinel{
+		cfg:     cfg,
+		primary: cfg.Primary,
+		nodes: map[string]*node{
+			cfg.Primary: {seen: time.Now()},
+		},
+		stopped: make(chan struct{}),
+	}
+}
+
+func (s *Sentinel) Start() {
+	go s.run()
+}
+
+func (s *Sentinel) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	select {
+	case <-s.stopped:
+	default:
+		close(s.stopped)
+	}
+}
+
+func (s *Sentinel) run() {
+	ticker := time.NewTicker(s.cfg.CheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.stopped:
+			return
+		case <-ticker.C:
+		}
+		s.check()
+		s.reconfigure()
+		if s.shouldFailover() {
+			s.elect()
+		}
+	}
+}
+
+// majority is the number of votes needed to run a failover.
+func (s *Sentinel) majority() int {
+	n := (len(s.cfg.Peers)+1)/2 + 1
+	if s.cfg.Quorum > n {
+		return s.cfg.Quorum
+	}
+	return n
+}
+
+// down reports whether the primary did not answer for cfg.DownAfter. Must
+// be called with s.mu held.
+func (s *Sentinel) down(now time.Time) bool {
+	n, ok := s.nodes[s.primary]
+	return !ok || now.Sub(n.seen) > s.cfg.DownAfter
+}
+
+// adopt switches to the configuration of epoch with primary, if it is newer
+// than the current one. Must be called with s.mu held.
+func (s *Sentinel) adopt(primary string, epoch int64) {
+	if epoch <= s.epoch || primary == "" {
+		return
+	}
+	s.primary, s.epoch = primary, epoch
+	if epoch > s.currentEpoch {
+		s.currentEpoch = epoch
+	}
+	if _, ok := s.nodes[primary]; !ok {
+		s.nodes[primary] = &node{seen: time.Now()}
+	}
+}
+
+// check asks every known node for its state, learning about replicas from
+// the primary and about newer configurations from any node.
+func (s *Sentinel) check() {
+	s.mu.Lock()
+	addresses := make([]string, 0, len(s.nodes))
+	for address := range s.nodes {
+		addresses = append(addresses, address)
+	}
+	s.mu.Unlock()
+
+	states := make([]*pb.ReplicationState, len(addresses))
+	var wg sync.WaitGroup
+	for i, address := range addresses {
+		wg.Add(1)
+		go func(i int, address string) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), s.cfg.CheckInterval)
+			defer cancel()
+			states[i], _ = s.cfg.Transport.ReplicationInfo(ctx, address)
+		}(i, address)
+	}
+	wg.Wait()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for i, st := range states {
+		if st == nil {
+			continue
+		}
+		n := s.nodes[addresses[i]]
+		n.state, n.seen = st, now
+		if st.Role == rolePrimary {
+			s.adopt(addresses[i], st.Epoch)
+		} else {
+			s.adopt(st.Primary, st.Epoch)
+		}
+	}
+	if n := s.nodes[s.primary]; n.state != nil && n.state.Role == rolePrimary {
+		for _, r := range n.state.Replicas {
+			if _, ok := s.nodes[r.Address]; !ok {
+				s.nodes[r.Address] = &node{seen: now}
+			}
+		}
+	}
+}
+
+// reconfigure repoints the nodes which do not replicate the current
+// primary, such as a former primary coming back, while the primary is up.
+func (s *Sentinel) reconfigure() {
+	s.mu.Lock()
+	now := time.Now()
+	if s.down(now) {
+		s.mu.Unlock()
+		return
+	}
+	req := &pb.ReplicaOfRequest{Address: s.primary, Epoch: s.epoch}
+	var targets []string
+	for address, n := range s.nodes {
+		if address == s.primary || n.state == nil || now.Sub(n.seen) > s.cfg.DownAfter {
+			continue
+		}
+		if n.state.Epoch <= s.epoch && (n.state.Role != roleReplica || n.state.Primary != s.primary) {
+			targets = append(targets, address)
+		}
+	}
+	s.mu.Unlock()
+	s.replicaOf(targets, req)
+}
+
+func (s *Sentinel) replicaOf(targets []string, req *pb.ReplicaOfRequest) {
+	var wg sync.WaitGroup
+	for _, address := range targets {
+		wg.Add(1)
+		go func(address string) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), s.cfg.CheckInterval)
+			defer cancel()
+			s.cfg.Transport.ReplicaOf(ctx, address, req)
+		}(address)
+	}
+	wg.Wait()
+}
+
+func (s *Sentinel) shouldFailover() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	return s.down(now) && now.After(s.nextFailover)
+}
+
+// retryDelay returns a random delay before the next failover, so that
+// sentinels whose votes were split do not split them again.
+func (s *Sentinel) retryDelay() time.Duration {
+	return s.cfg.DownAfter + time.Duration(rand.Int63n(int64(s.cfg.DownAfter)))
+}
+
+// elect asks the other sentinels whether they also see the primary down
+// and to vote for this sentinel to run the failover in a new epoch.
+func (s *Sentinel) elect() {
+	s.mu.Lock()
+	s.currentEpoch++
+	epoch, primary := s.currentEpoch, s.primary
+	votes := 0
+	if s.votedEpoch < epoch {
+		s.votedEpoch, s.votedFor = epoch, s.cfg.ID
+		votes++
+	}
+	s.nextFailover = time.Now().Add(s.retryDelay())
+	s.mu.Unlock()
+
+	req := &pb.SentinelVoteRequest{Primary: primary, Epoch: epoch, Candidate: s.cfg.ID}
+	resps := make([]*pb.SentinelVoteResponse, len(s.cfg.Peers))
+	var wg sync.WaitGroup
+	for i, address := range s.cfg.Peers {
+		wg.Add(1)
+		go func(i int, address string) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), s.cfg.CheckInterval)
+			defer cancel()
+			resps[i], _ = s.cfg.Transport.SentinelVote(ctx, address, req)
+		}(i, address)
+	}
+	wg.Wait()
+
+	down := 1
+	for _, resp := range resps {
+		if resp == nil {
+			continue
+		}
+		if resp.Down {
+			down++
+		}
+		if resp.Leader == s.cfg.ID {
+			votes++
+		}
+		s.mu.Lock()
+		if resp.Epoch > s.currentEpoch {
+			s.currentEpoch = resp.Epoch
+		}
+		s.mu.Unlock()
+	}
+	if down < s.cfg.Quorum || votes < s.majority() {
+		return
+	}
+	s.failover(primary, epoch)
+}
+
+// best returns the most up-to-date replica which answered recently, other
+// than the failed primary. Must be called with s.mu held.
+func (s *Sentinel) best(failed string, now time.Time) string {
+	var best string
+	var offset int64
+	for address, n := range s.nodes {
+		if address == failed || n.state == nil || n.state.Role != roleReplica ||
+			now.Sub(n.seen) > s.cfg.DownAfter {
+			continue
+		}
+		if best == "" || n.state.Offset > offset || (n.state.Offset == offset && address < best) {
+			best, offset = address, n.state.Offset
+		}
+	}
+	return best
+}
+
+// failover promotes the best replica to replace failed in epoch, repoints
+// the other nodes and tells the other sentinels.
+func (s *Sentinel) failover(failed string, epoch int64) error {
+	s.mu.Lock()
+	if epoch <= s.epoch {
+		s.mu.Unlock()
+		return ErrStaleEpoch
+	}
+	best := s.best(failed, time.Now())
+	s.mu.Unlock()
+	if best == "" {
+		return ErrNoReplica
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.cfg.CheckInterval)
+	_, err := s.cfg.Transport.ReplicaOf(ctx, best, &pb.ReplicaOfRequest{Epoch: epoch})
+	cancel()
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.adopt(best, epoch)
+	var others []string
+	for address := range s.nodes {
+		if address != best {
+			others = append(others, address)
+		}
+	}
+	announce := s.primaryState(time.Now())
+	s.mu.Unlock()
+
+	s.replicaOf(others, &pb.ReplicaOfRequest{Address: best, Epoch: epoch})
+	var wg sync.WaitGroup
+	for _, address := range s.cfg.Peers {
+		wg.Add(1)
+		go func(address string) {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(context.Background(), s.cfg.CheckInterval)
+			defer cancel()
+			s.cfg.Transport.SentinelAnnounce(ctx, address, announce)
+		}(address)
+	}
+	wg.Wait()
+	return nil
+}
+
+// primaryState returns the current primary with the replicas which
+// answered recently. Must be called with s.mu held.
+func (s *Sentinel) primaryState(now time.Time) *pb.SentinelPrimary {
+	st := &pb.SentinelPrimary{Address: s.primary, Epoch: s.epoch}
+	for address, n := range s.nodes {
+		if address != s.primary && n.state != nil && n.state.Role == roleReplica &&
+			now.Sub(n.seen) <= s.cfg.DownAfter {
+			st.Replicas = append(st.Replicas, address)
+		}
+	}
+	sort.Strings(st.Replicas)
+	return st
+}
+
+// Primary returns the current primary and its replicas.
+func (s *Sentinel) Primary() *pb.SentinelPrimary {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.primaryState(time.Now())
+}
+
+// SentinelVote tells whether this sentinel sees the primary down too, and
+// votes for the candidate unless it already voted in the epoch.
+func (s *Sentinel) SentinelVote(ctx context.Context, req *pb.SentinelVoteRequest) (*pb.SentinelVoteResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	down := req.Primary == s.primary && s.down(now)
+	if req.Epoch > s.currentEpoch {
+		s.currentEpoch = req.Epoch
+	}
+	if down && req.Epoch > s.votedEpoch {
+		s.votedEpoch, s.votedFor = req.Epoch, req.Candidate
+		s.nextFailover = now.Add(s.retryDelay())
+	}
+	resp := &pb.SentinelVoteResponse{Down: down, Epoch: s.currentEpoch}
+	if s.votedEpoch == req.Epoch {
+		resp.Leader = s.votedFor
+	}
+	return resp, nil
+}
+
+// SentinelAnnounce adopts the configuration of another sentinel if it is
+// newer, and returns the current one.
+func (s *Sentinel) SentinelAnnounce(ctx context.Context, req *pb.SentinelPrimary) (*pb.SentinelPrimary, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	s.adopt(req.Address, req.Epoch)
+	for _, address := range req.Replicas {
+		if _, ok := s.nodes[address]; !ok {
+			s.nodes[address] = &node{seen: now}
+		}
+	}
+	return s.primaryState(now), nil
+}
+
+// SentinelGetPrimary returns the current primary, for clients to discover
+// where to send writes.
+func (s *Sentinel) SentinelGetPrimary(ctx context.Context, _ *empty.Empty) (*pb.SentinelPrimary, error) {
+	return s.Primary(), nil
+}
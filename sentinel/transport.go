@@ -0,0 +1,77 @@
+package sentinel
+
+import (
+	"context"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/shanukun/cash/cash_proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Transport sends requests to the monitored nodes and to other sentinels,
+// given their address.
+type Transport interface {
+	ReplicationInfo(ctx context.Context, address string) (*pb.ReplicationState, error)
+	ReplicaOf(ctx context.Context, address string, req *pb.ReplicaOfRequest) (*pb.ReplicationState, error)
+	SentinelVote(ctx context.Context, address string, req *pb.SentinelVoteRequest) (*pb.SentinelVoteResponse, error)
+	SentinelAnnounce(ctx context.Context, address string, req *pb.SentinelPrimary) (*pb.SentinelPrimary, error)
+}
+
+// GRPCTransport sends requests to the ReplicationService of nodes and the
+// SentinelService of sentinels, keeping one connection per address.
+type GRPCTransport struct {
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+func NewGRPCTransport() *GRPCTransport {
+	return &GRPCTransport{conns: make(map[string]*grpc.ClientConn)}
+}
+
+func (t *GRPCTransport) conn(address string) (*grpc.ClientConn, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if conn, ok := t.conns[address]; ok {
+		return conn, nil
+	}
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	t.conns[address] = conn
+	return conn, nil
+}
+
+func (t *GRPCTransport) ReplicationInfo(ctx context.Context, address string) (*pb.ReplicationState, error) {
+	conn, err := t.conn(address)
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewReplicationServiceClient(conn).ReplicationInfo(ctx, &empty.Empty{})
+}
+
+func (t *GRPCTransport) ReplicaOf(ctx context.Context, address string, req *pb.ReplicaOfRequest) (*pb.ReplicationState, error) {
+	conn, err := t.conn(address)
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewReplicationServiceClient(conn).ReplicaOf(ctx, req)
+}
+
+func (t *GRPCTransport) SentinelVote(ctx context.Context, address string, req *pb.SentinelVoteRequest) (*pb.SentinelVoteResponse, error) {
+	conn, err := t.conn(address)
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewSentinelServiceClient(conn).SentinelVote(ctx, req)
+}
+
+func (t *GRPCTransport) SentinelAnnounce(ctx context.Context, address string, req *pb.SentinelPrimary) (*pb.SentinelPrimary, error) {
+	conn, err := t.conn(address)
+	if err != nil {
+		return nil, err
+	}
+	return pb.NewSentinelServiceClient(conn).SentinelAnnounce(ctx, req)
+}
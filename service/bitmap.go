@@ -0,0 +1,410 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strconv"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
+)
+
+var (
+	ErrBitOffset   = errors.New("Bit offset is out of range")
+	ErrBitValue    = errors.New("Bit must be 0 or 1")
+	ErrBitOp       = errors.New("NOT takes exactly one key")
+	ErrBitEncoding = errors.New("Invalid bitfield encoding")
+)
+
+// maxBitOffset limits values to 512 MiB.
+const maxBitOffset = 1<<32 - 1
+
+// getStringValue returns the string at key. Must be called with c.mu held.
+func (c *cache) getStringValue(key string) (*dt.StringT, error) {
+	kr := genKeyReport(c, key, stringType)
+	if !kr.exists {
+		return nil, ErrNoKey
+	}
+	if !kr.typeMatch {
+		return nil, ErrWrongType
+	}
+
+	str := (kr.val).(*dt.StringT)
+	if isExpired(str.Expiration) {
+		return nil, ErrKeyExpired
+	}
+	return str, nil
+}
+
+// createStringValue returns the string at key, creating an empty one if
+// it does not exist. Must be called with c.mu held.
+func (c *cache) createStringValue(key string, expiration int64) (*dt.StringT, error) {
+	str, err := c.getStringValue(key)
+	if err != ErrNoKey {
+		return str, err
+	}
+
+	str = &dt.StringT{
+		Expiration: expiration,
+	}
+	c.store.Insert(key, dt.AnyT(str))
+	c.expList[key] = expiration
+	return str, nil
+}
+
+// SetBit sets the bit at offset and returns the previous bit.
+func (c *cache) SetBit(ctx context.Context, args *pb.BitRequest) (*pb.Count, error) {
+	if args.Offset < 0 || args.Offset > maxBitOffset {
+		return nil, ErrBitOffset
+	}
+	if args.Value != 0 && args.Value != 1 {
+		return nil, ErrBitValue
+	}
+
+	c.mu.Lock()
+	str, err := c.createStringValue(args.Key, getExpiration(args.Expiration))
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+	old := str.SetBit(uint64(args.Offset), uint8(args.Value))
+	str.Version++
+	c.indexKey(args.Key)
+	c.notify(pb.EventType_UPDATE, args.Key)
+	c.mu.Unlock()
+
+	return &pb.Count{
+		Count: int64(old),
+	}, nil
+}
+
+func (c *cache) GetBit(ctx context.Context, args *pb.BitRequest) (*pb.Count, error) {
+	if args.Offset < 0 {
+		return nil, ErrBitOffset
+	}
+
+	c.mu.RLock()
+	str, err := c.getStringValue(args.Key)
+	if err != nil && err != ErrNoKey {
+		c.mu.RUnlock()
+		return nil, err
+	}
+
+	var bit uint8
+	if str != nil {
+		bit = str.GetBit(uint64(args.Offset))
+	}
+	c.mu.RUnlock()
+
+	return &pb.Count{
+		Count: int64(bit),
+	}, nil
+}
+
+// BitCount returns the number of set bits, only those in args.Range if
+// it is given.
+func (c *cache) BitCount(ctx context.Context, args *pb.BitCountRequest) (*pb.Count, error) {
+	c.mu.RLock()
+	str, err := c.getStringValue(args.Key)
+	if err != nil && err != ErrNoKey {
+		c.mu.RUnlock()
+		return nil, err
+	}
+
+	var count int64
+	if str != nil {
+		start, end, bitUnit := int64(0), int64(-1), false
+		if args.Range != nil {
+			start, end, bitUnit = args.Range.Start, args.Range.End, args.Range.Bit
+		}
+		if first, last, ok := str.BitRange(start, end, bitUnit); ok {
+			count = str.BitCount(first, last)
+		}
+	}
+	c.mu.RUnlock()
+
+	return &pb.Count{
+		Count: count,
+	}, nil
+}
+
+// BitPos returns the position of the first bit set to args.Bit, or -1. If
+// clear bits are searched without a range and all bits are set, the
+// position right after the value is returned.
+func (c *cache) BitPos(ctx context.Context, args *pb.BitPosRequest) (*pb.Count, error) {
+	if args.Bit != 0 && args.Bit != 1 {
+		return nil, ErrBitValue
+	}
+
+	c.mu.RLock()
+	str, err := c.getStringValue(args.Key)
+	if err != nil && err != ErrNoKey {
+		c.mu.RUnlock()
+		return nil, err
+	}
+	if str == nil {
+		str = &dt.StringT{}
+	}
+
+	start, end, bitUnit := int64(0), int64(-1), false
+	if args.Range != nil {
+		start, end, bitUnit = args.Range.Start, args.Range.End, args.Range.Bit
+	}
+
+	pos := int64(-1)
+	if first, last, ok := str.BitRange(start, end, bitUnit); ok {
+		pos = str.BitPos(uint8(args.Bit), first, last)
+	}
+	if pos == -1 && args.Bit == 0 && args.Range == nil {
+		pos = int64(len(str.Data)) * 8
+	}
+	c.mu.RUnlock()
+
+	return &pb.Count{
+		Count: pos,
+	}, nil
+}
+
+// BitOp stores the result of a bitwise operation over keys at dest and
+// returns its length. Missing keys are treated as zero bytes and shorter
+// values are padded with zero bytes.
+func (c *cache) BitOp(ctx context.Context, args *pb.BitOpRequest) (*pb.Count, error) {
+	if args.Op == pb.BitOperation_NOT && len(args.Keys) != 1 {
+		return nil, ErrBitOp
+	}
+
+	c.mu.Lock()
+	var values [][]byte
+	length := 0
+	for _, key := range args.Keys {
+		str, err := c.getStringValue(key)
+		if err != nil && err != ErrNoKey && err != ErrKeyExpired {
+			c.mu.Unlock()
+			return nil, err
+		}
+		var data []byte
+		if err == nil {
+			data = str.Data
+		}
+		values = append(values, data)
+		if len(data) > length {
+			length = len(data)
+		}
+	}
+
+	res := make([]byte, length)
+	for i := range res {
+		var b byte
+		for j, data := range values {
+			var v byte
+			if i < len(data) {
+				v = data[i]
+			}
+			switch {
+			case j == 0:
+				b = v
+			case args.Op == pb.BitOperation_AND:
+				b &= v
+			case args.Op == pb.BitOperation_OR:
+				b |= v
+			case args.Op == pb.BitOperation_XOR:
+				b ^= v
+			}
+		}
+		if args.Op == pb.BitOperation_NOT {
+			b = ^b
+		}
+		res[i] = b
+	}
+
+	kr := genKeyReport(c, args.Dest, stringType)
+	if kr.exists && !kr.typeMatch {
+		c.mu.Unlock()
+		return nil, ErrWrongType
+	}
+	if length == 0 {
+		if kr.exists {
+			c.store.Delete(args.Dest)
+			delete(c.expList, args.Dest)
+			c.indexKey(args.Dest)
+			c.notify(pb.EventType_DELETE, args.Dest)
+		}
+	} else {
+		str := &dt.StringT{}
+		if kr.exists {
+			str = (kr.val).(*dt.StringT)
+		} else {
+			c.store.Insert(args.Dest, dt.AnyT(str))
+		}
+		str.Data = res
+		str.Expiration = 0
+		str.Version++
+		c.expList[args.Dest] = 0
+		c.indexKey(args.Dest)
+		c.notify(pb.EventType_SET, args.Dest)
+	}
+	c.mu.Unlock()
+
+	return &pb.Count{
+		Count: int64(length),
+	}, nil
+}
+
+type bitEncoding struct {
+	signed bool
+	width  uint64
+	min    int64
+	max    int64
+}
+
+// parseBitEncoding parses encodings such as "u8" or "i5". Unsigned values
+// are limited to 63 bits so that they fit into an int64.
+func parseBitEncoding(s string) (bitEncoding, error) {
+	if len(s) < 2 || (s[0] != 'u' && s[0] != 'i') {
+		return bitEncoding{}, ErrBitEncoding
+	}
+	width, err := strconv.ParseUint(s[1:], 10, 8)
+	if err != nil || width < 1 {
+		return bitEncoding{}, ErrBitEncoding
+	}
+
+	e := bitEncoding{
+		signed: s[0] == 'i',
+		width:  width,
+	}
+	switch {
+	case e.signed && width == 64:
+		e.min, e.max = math.MinInt64, math.MaxInt64
+	case e.signed && width < 64:
+		e.min, e.max = -1<<(width-1), 1<<(width-1)-1
+	case !e.signed && width < 64:
+		e.min, e.max = 0, 1<<width-1
+	default:
+		return bitEncoding{}, ErrBitEncoding
+	}
+	return e, nil
+}
+
+// decode converts the low width bits of v to a value of the encoding.
+func (e bitEncoding) decode(v uint64) int64 {
+	if e.signed && e.width < 64 && v&(1<<(e.width-1)) != 0 {
+		v |= ^uint64(0) << e.width
+	}
+	if !e.signed {
+		v &= 1<<e.width - 1
+	}
+	return int64(v)
+}
+
+// fit applies the overflow policy to v, the result of computing wrapped
+// in 64 bits, where overflowed reports whether the computation overflowed
+// and up whether the overflow was upwards.
+func (e bitEncoding) fit(v int64, overflowed, up bool, policy pb.Overflow) (int64, bool) {
+	if !overflowed && v >= e.min && v <= e.max {
+		return v, true
+	}
+	if !overflowed {
+		up = v > e.max
+	}
+
+	switch policy {
+	case pb.Overflow_SAT:
+		if up {
+			return e.max, true
+		}
+		return e.min, true
+	case pb.Overflow_FAIL:
+		return 0, false
+	}
+	return e.decode(uint64(v)), true
+}
+
+// bitFieldTarget returns the encoding and bit offset of op.
+func bitFieldTarget(op *pb.BitFieldOp) (bitEncoding, int64, error) {
+	e, err := parseBitEncoding(op.Encoding)
+	if err != nil {
+		return e, 0, err
+	}
+	offset := op.Offset
+	if op.TypedOffset {
+		offset *= int64(e.width)
+	}
+	if offset < 0 || offset+int64(e.width) > maxBitOffset+1 {
+		return e, 0, ErrBitOffset
+	}
+	return e, offset, nil
+}
+
+func bitFieldOp(str *dt.StringT, op *pb.BitFieldOp) *pb.BitFieldValue {
+	e, offset, _ := bitFieldTarget(op)
+
+	old := e.decode(str.GetBits(uint64(offset), e.width))
+	if op.Type == pb.BitFieldOpType_BITFIELD_GET {
+		return &pb.BitFieldValue{Value: old, Ok: true}
+	}
+
+	var v int64
+	var ok bool
+	if op.Type == pb.BitFieldOpType_BITFIELD_SET {
+		v, ok = e.fit(op.Value, false, false, op.Overflow)
+	} else {
+		sum := old + op.Value
+		overflowed := (op.Value > 0 && sum < old) || (op.Value < 0 && sum > old)
+		v, ok = e.fit(sum, overflowed, op.Value > 0, op.Overflow)
+	}
+	if !ok {
+		return &pb.BitFieldValue{}
+	}
+
+	str.SetBits(uint64(offset), e.width, uint64(v))
+	if op.Type == pb.BitFieldOpType_BITFIELD_SET {
+		return &pb.BitFieldValue{Value: old, Ok: true}
+	}
+	return &pb.BitFieldValue{Value: v, Ok: true}
+}
+
+// BitField runs ops in order, treating the value as an array of integers
+// of arbitrary width. GET returns the value, SET the previous value and
+// INCRBY the new value. Ops that fail due to the FAIL overflow policy
+// return a value that is not ok.
+func (c *cache) BitField(ctx context.Context, args *pb.BitFieldRequest) (*pb.BitFieldResult, error) {
+	readOnly := true
+	for _, op := range args.Ops {
+		if _, _, err := bitFieldTarget(op); err != nil {
+			return nil, err
+		}
+		if op.Type != pb.BitFieldOpType_BITFIELD_GET {
+			readOnly = false
+		}
+	}
+
+	c.mu.Lock()
+	var str *dt.StringT
+	var err error
+	if readOnly {
+		str, err = c.getStringValue(args.Key)
+		if err == ErrNoKey {
+			str, err = &dt.StringT{}, nil
+		}
+	} else {
+		str, err = c.createStringValue(args.Key, getExpiration(args.Expiration))
+	}
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	res := &pb.BitFieldResult{}
+	for _, op := range args.Ops {
+		res.Values = append(res.Values, bitFieldOp(str, op))
+	}
+	if !readOnly {
+		str.Version++
+		c.indexKey(args.Key)
+		c.notify(pb.EventType_UPDATE, args.Key)
+	}
+	c.mu.Unlock()
+
+	return res, nil
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/shanukun/cash/cluster"
+	"github.com/shanukun/cash/dynamo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Dynamo replicates the keys of a cache without a leader, through the
+// ring of a dynamo.Node. Requests must carry keys, all kept by the same
+// nodes; Publish stays local.
+type Dynamo struct {
+	node *dynamo.Node
+}
+
+// NewDynamo returns the ring member storing keys in c, to be started with
+// Start.
+func NewDynamo(c *Cache, cfg dynamo.Config) (*Dynamo, error) {
+	cfg.Store = c.cache
+	node, err := dynamo.New(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Dynamo{node: node}, nil
+}
+
+func (d *Dynamo) Node() *dynamo.Node {
+	return d.node
+}
+
+func (d *Dynamo) Start() {
+	d.node.Start()
+}
+
+func (d *Dynamo) Stop() {
+	d.node.Stop()
+}
+
+func (d *Dynamo) handle(ctx context.Context, method string, req interface{}, handler grpc.UnaryHandler) (interface{}, error) {
+	name := strings.TrimPrefix(method, "/CacheService/")
+	if name == "Publish" {
+		return handler(ctx, req)
+	}
+	if isBlocking(req) {
+		return nil, status.Error(codes.InvalidArgument, "Blocking requests are not supported in Dynamo mode")
+	}
+	keys := cluster.RequestKeys(req)
+	if len(keys) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Requests without keys are not supported in Dynamo mode")
+	}
+	run := func(ctx context.Context) (interface{}, error) {
+		return handler(ctx, req)
+	}
+	return d.node.Serve(ctx, method, req, keys, !readOnlyMethods[name], run)
+}
+
+// UnaryInterceptor serves the requests to CacheService through the ring.
+// Quorums are set per request with dynamo.WithQuorum.
+func (d *Dynamo) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, "/CacheService/") {
+			return handler(ctx, req)
+		}
+		return d.handle(ctx, info.FullMethod, req, handler)
+	}
+}
@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
+)
+
+var (
+	ErrKeyExists     = errors.New("Key already exists")
+	ErrFilterFull    = errors.New("Filter is full")
+	ErrInvalidFilter = errors.New("Invalid filter parameters")
+)
+
+const (
+	defaultBloomCapacity  = 100
+	defaultBloomErrorRate = 0.01
+	defaultBloomExpansion = 2
+	defaultCuckooCapacity = 1024
+)
+
+// getBloom returns the Bloom filter at key. Must be called with c.mu held.
+func (c *cache) getBloom(key string) (*dt.BloomT, error) {
+	kr := genKeyReport(c, key, bloomType)
+	if !kr.exists {
+		return nil, ErrNoKey
+	}
+	if !kr.typeMatch {
+		return nil, ErrWrongType
+	}
+
+	bloom := (kr.val).(*dt.BloomT)
+	if isExpired(bloom.Expiration) {
+		return nil, ErrKeyExpired
+	}
+	return bloom, nil
+}
+
+func (c *cache) BFReserve(ctx context.Context, args *pb.BloomReserveRequest) (*pb.Response, error) {
+	if args.Capacity <= 0 || args.ErrorRate <= 0 || args.ErrorRate >= 1 || args.Expansion < 0 {
+		return nil, ErrInvalidFilter
+	}
+	expansion := uint64(args.Expansion)
+	if expansion == 0 {
+		expansion = defaultBloomExpansion
+	}
+
+	c.mu.Lock()
+	kr := genKeyReport(c, args.Key, bloomType)
+	if kr.exists && !isExpired(dt.Expiration(kr.val)) {
+		c.mu.Unlock()
+		return nil, ErrKeyExists
+	}
+	c.store.Delete(args.Key)
+
+	expiration := getExpiration(args.Expiration)
+	bloom := dt.NewBloom(uint64(args.Capacity), args.ErrorRate, expansion, args.NonScaling, expiration)
+	c.store.Insert(args.Key, dt.AnyT(bloom))
+	c.expList[args.Key] = expiration
+	c.notify(pb.EventType_UPDATE, args.Key)
+	c.mu.Unlock()
+
+	return &pb.Response{
+		Response: true,
+	}, nil
+}
+
+// BFAdd adds items to the Bloom filter at key, creating it with default
+// parameters if it does not exist. Each result is true if the item was
+// not present before.
+func (c *cache) BFAdd(ctx context.Context, args *pb.FilterItems) (*pb.Results, error) {
+	c.mu.Lock()
+	bloom, err := c.getBloom(args.Key)
+	changed := false
+	if err == ErrNoKey {
+		expiration := getExpiration(args.Expiration)
+		bloom = dt.NewBloom(defaultBloomCapacity, defaultBloomErrorRate, defaultBloomExpansion, false, expiration)
+		c.store.Insert(args.Key, dt.AnyT(bloom))
+		c.expList[args.Key] = expiration
+		changed = true
+		err = nil
+	}
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	res := &pb.Results{}
+	for _, item := range args.Items {
+		added, full := bloom.Add(item)
+		if full {
+			err = ErrFilterFull
+			break
+		}
+		changed = changed || added
+		res.Results = append(res.Results, added)
+	}
+	// Items added before the filter filled up are kept.
+	if changed {
+		c.notify(pb.EventType_UPDATE, args.Key)
+	}
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *cache) BFExists(ctx context.Context, args *pb.FilterItems) (*pb.Results, error) {
+	c.mu.RLock()
+	bloom, err := c.getBloom(args.Key)
+	if err != nil && err != ErrNoKey {
+		c.mu.RUnlock()
+		return nil, err
+	}
+
+	res := &pb.Results{}
+	for _, item := range args.Items {
+		res.Results = append(res.Results, bloom != nil && bloom.Exists(item))
+	}
+	c.mu.RUnlock()
+
+	return res, nil
+}
+
+func (c *cache) BFInfo(ctx context.Context, args *pb.Key) (*pb.FilterInfo, error) {
+	c.mu.RLock()
+	bloom, err := c.getBloom(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+
+	info := &pb.FilterInfo{
+		Capacity:  int64(bloom.Capacity()),
+		Size:      int64(bloom.Size()),
+		Items:     int64(bloom.Count()),
+		Filters:   int64(len(bloom.Layers)),
+		ErrorRate: bloom.ErrorRate,
+	}
+	c.mu.RUnlock()
+
+	return info, nil
+}
+
+// getCuckoo returns the cuckoo filter at key. Must be called with c.mu
+// held.
+func (c *cache) getCuckoo(key string) (*dt.CuckooT, error) {
+	kr := genKeyReport(c, key, cuckooType)
+	if !kr.exists {
+		return nil, ErrNoKey
+	}
+	if !kr.typeMatch {
+		return nil, ErrWrongType
+	}
+
+	cuckoo := (kr.val).(*dt.CuckooT)
+	if isExpired(cuckoo.Expiration) {
+		return nil, ErrKeyExpired
+	}
+	return cuckoo, nil
+}
+
+func (c *cache) CFReserve(ctx context.Context, args *pb.CuckooReserveRequest) (*pb.Response, error) {
+	if args.Capacity <= 0 {
+		return nil, ErrInvalidFilter
+	}
+
+	c.mu.Lock()
+	kr := genKeyReport(c, args.Key, cuckooType)
+	if kr.exists && !isExpired(dt.Expiration(kr.val)) {
+		c.mu.Unlock()
+		return nil, ErrKeyExists
+	}
+	c.store.Delete(args.Key)
+
+	expiration := getExpiration(args.Expiration)
+	c.store.Insert(args.Key, dt.AnyT(dt.NewCuckoo(uint64(args.Capacity), expiration)))
+	c.expList[args.Key] = expiration
+	c.notify(pb.EventType_UPDATE, args.Key)
+	c.mu.Unlock()
+
+	return &pb.Response{
+		Response: true,
+	}, nil
+}
+
+// CFAdd adds items to the cuckoo filter at key, creating it with default
+// capacity if it does not exist. If args.Nx is set, items that may already
+// be present are not added again. Each result is true if the item was
+// added.
+func (c *cache) CFAdd(ctx context.Context, args *pb.FilterItems) (*pb.Results, error) {
+	c.mu.Lock()
+	cuckoo, err := c.getCuckoo(args.Key)
+	changed := false
+	if err == ErrNoKey {
+		expiration := getExpiration(args.Expiration)
+		cuckoo = dt.NewCuckoo(defaultCuckooCapacity, expiration)
+		c.store.Insert(args.Key, dt.AnyT(cuckoo))
+		c.expList[args.Key] = expiration
+		changed = true
+		err = nil
+	}
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	res := &pb.Results{}
+	for _, item := range args.Items {
+		if args.Nx && cuckoo.Exists(item) {
+			res.Results = append(res.Results, false)
+			continue
+		}
+		if !cuckoo.Add(item) {
+			err = ErrFilterFull
+			break
+		}
+		changed = true
+		res.Results = append(res.Results, true)
+	}
+	// Items added before the filter filled up are kept.
+	if changed {
+		c.notify(pb.EventType_UPDATE, args.Key)
+	}
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (c *cache) CFExists(ctx context.Context, args *pb.FilterItems) (*pb.Results, error) {
+	c.mu.RLock()
+	cuckoo, err := c.getCuckoo(args.Key)
+	if err != nil && err != ErrNoKey {
+		c.mu.RUnlock()
+		return nil, err
+	}
+
+	res := &pb.Results{}
+	for _, item := range args.Items {
+		res.Results = append(res.Results, cuckoo != nil && cuckoo.Exists(item))
+	}
+	c.mu.RUnlock()
+
+	return res, nil
+}
+
+// CFDel deletes one occurrence of each item. Deleting an item that was
+// never added may remove another item with the same fingerprint.
+func (c *cache) CFDel(ctx context.Context, args *pb.FilterItems) (*pb.Results, error) {
+	c.mu.Lock()
+	cuckoo, err := c.getCuckoo(args.Key)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	res := &pb.Results{}
+	for _, item := range args.Items {
+		res.Results = append(res.Results, cuckoo.Delete(item))
+	}
+	c.notify(pb.EventType_UPDATE, args.Key)
+	c.mu.Unlock()
+
+	return res, nil
+}
+
+func (c *cache) CFInfo(ctx context.Context, args *pb.Key) (*pb.FilterInfo, error) {
+	c.mu.RLock()
+	cuckoo, err := c.getCuckoo(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+
+	info := &pb.FilterInfo{
+		Capacity: int64(cuckoo.Capacity()),
+		Size:     int64(cuckoo.Size()),
+		Items:    int64(cuckoo.Count),
+		Filters:  1,
+	}
+	c.mu.RUnlock()
+
+	return info, nil
+}
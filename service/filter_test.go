@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "github.com/shanukun/cash/cash_proto"
+)
+
+func TestFilterSnapshot(t *testing.T) {
+	ctx := context.Background()
+	c := NewCacheService(time.Minute, time.Minute)
+	var items []string
+	for i := 0; i < 500; i++ {
+		items = append(items, fmt.Sprint("item", i))
+	}
+	// The Bloom filter grows a second layer.
+	if _, err := c.BFReserve(ctx, &pb.BloomReserveRequest{Key: "bloom", Capacity: 300, ErrorRate: 0.01, Expansion: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.BFAdd(ctx, &pb.FilterItems{Key: "bloom", Items: items}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CFAdd(ctx, &pb.FilterItems{Key: "cuckoo", Items: items}); err != nil {
+		t.Fatal(err)
+	}
+
+	r := restored(t, c)
+	for _, key := range []string{"bloom", "cuckoo"} {
+		want, err := c.BFInfo(ctx, &pb.Key{Key: key})
+		if key == "cuckoo" {
+			want, err = c.CFInfo(ctx, &pb.Key{Key: key})
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := r.BFInfo(ctx, &pb.Key{Key: key})
+		if key == "cuckoo" {
+			got, err = r.CFInfo(ctx, &pb.Key{Key: key})
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Capacity != want.Capacity || got.Size != want.Size || got.Items != want.Items {
+			t.Errorf("%s: info %v after restore, want %v", key, got, want)
+		}
+	}
+
+	bloom, err := r.BFExists(ctx, &pb.FilterItems{Key: "bloom", Items: items})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cuckoo, err := r.CFExists(ctx, &pb.FilterItems{Key: "cuckoo", Items: items})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range items {
+		if !bloom.Results[i] || !cuckoo.Results[i] {
+			t.Fatalf("%s missing after restore", items[i])
+		}
+	}
+
+	// Items are still deleted from the restored cuckoo filter.
+	del, err := r.CFDel(ctx, &pb.FilterItems{Key: "cuckoo", Items: items[:1]})
+	if err != nil || !del.Results[0] {
+		t.Fatalf("delete after restore: %v %v", del, err)
+	}
+}
+
+func TestFilterFullRecordsChanges(t *testing.T) {
+	ctx := context.Background()
+	c := NewCacheService(time.Minute, time.Minute)
+	if _, err := c.BFReserve(ctx, &pb.BloomReserveRequest{Key: "bloom", Capacity: 10, ErrorRate: 0.01, NonScaling: true}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.CFReserve(ctx, &pb.CuckooReserveRequest{Key: "cuckoo", Capacity: 10}); err != nil {
+		t.Fatal(err)
+	}
+	var items []string
+	for i := 0; i < 1000; i++ {
+		items = append(items, fmt.Sprint("item", i))
+	}
+
+	// The items added before a filter fills up are kept, and replicated.
+	for key, add := range map[string]func(context.Context, *pb.FilterItems) (*pb.Results, error){
+		"bloom":  c.BFAdd,
+		"cuckoo": c.CFAdd,
+	} {
+		c.TrackChanges()
+		if _, err := add(ctx, &pb.FilterItems{Key: key, Items: items}); err != ErrFilterFull {
+			t.Fatalf("%s: adding %d items failed with %v, want ErrFilterFull", key, len(items), err)
+		}
+		changes, err := c.DrainChanges()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if changes == nil || len(changes.Entries) != 1 || changes.Entries[0].Key != key {
+			t.Errorf("%s: changes %v recorded, want the filter", key, changes)
+		}
+	}
+}
+
+func TestFilterReserveExpired(t *testing.T) {
+	ctx := context.Background()
+	c := NewCacheService(time.Minute, time.Minute)
+	for _, key := range []string{"bloom", "cuckoo"} {
+		if _, err := c.Set(ctx, &pb.String{Key: key, Value: "v", Expiration: "1ms"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	if _, err := c.BFReserve(ctx, &pb.BloomReserveRequest{Key: "bloom", Capacity: 10, ErrorRate: 0.01}); err != nil {
+		t.Errorf("reserving a Bloom filter over an expired key: %v", err)
+	}
+	if _, err := c.CFReserve(ctx, &pb.CuckooReserveRequest{Key: "cuckoo", Capacity: 10}); err != nil {
+		t.Errorf("reserving a cuckoo filter over an expired key: %v", err)
+	}
+	if _, err := c.BFReserve(ctx, &pb.BloomReserveRequest{Key: "bloom", Capacity: 10, ErrorRate: 0.01}); err != ErrKeyExists {
+		t.Errorf("reserving an existing Bloom filter failed with %v, want ErrKeyExists", err)
+	}
+	if info, err := c.BFInfo(ctx, &pb.Key{Key: "bloom"}); err != nil || info.Capacity != 10 {
+		t.Errorf("info %v %v after reserve", info, err)
+	}
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
+)
+
+var (
+	ErrInvalidCoords = errors.New("Invalid longitude or latitude")
+	ErrInvalidUnit   = errors.New("Unit must be one of m, km, mi, ft")
+	ErrNoMember      = errors.New("No member found")
+	ErrInvalidShape  = errors.New("Search needs a center and a radius or box")
+)
+
+// unitMeters returns the number of meters in unit, meters by default.
+func unitMeters(unit string) (float64, error) {
+	switch unit {
+	case "", "m":
+		return 1, nil
+	case "km":
+		return 1000, nil
+	case "mi":
+		return 1609.34, nil
+	case "ft":
+		return 0.3048, nil
+	}
+	return 0, ErrInvalidUnit
+}
+
+// getGeo returns the geo index at key. Must be called with c.mu held.
+func (c *cache) getGeo(key string) (*dt.GeoT, error) {
+	kr := genKeyReport(c, key, geoType)
+	if !kr.exists {
+		return nil, ErrNoKey
+	}
+	if !kr.typeMatch {
+		return nil, ErrWrongType
+	}
+
+	geo := (kr.val).(*dt.GeoT)
+	if isExpired(geo.Expiration) {
+		return nil, ErrKeyExpired
+	}
+	return geo, nil
+}
+
+// GeoAdd sets the positions of members and returns the number of members
+// that were added.
+func (c *cache) GeoAdd(ctx context.Context, args *pb.GeoAddRequest) (*pb.Count, error) {
+	for _, m := range args.Members {
+		if !dt.ValidGeoPoint(m.Longitude, m.Latitude) {
+			return nil, ErrInvalidCoords
+		}
+	}
+
+	c.mu.Lock()
+	geo, err := c.getGeo(args.Key)
+	if err == ErrNoKey {
+		expiration := getExpiration(args.Expiration)
+		geo = dt.NewGeo(expiration)
+		c.store.Insert(args.Key, dt.AnyT(geo))
+		c.expList[args.Key] = expiration
+		err = nil
+	}
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	var added int64
+	for _, m := range args.Members {
+		if geo.Add(m.Member, m.Longitude, m.Latitude) {
+			added++
+		}
+	}
+	c.notify(pb.EventType_UPDATE, args.Key)
+	c.mu.Unlock()
+
+	return &pb.Count{
+		Count: added,
+	}, nil
+}
+
+func (c *cache) GeoRem(ctx context.Context, args *pb.GeoMembersRequest) (*pb.Count, error) {
+	c.mu.Lock()
+	geo, err := c.getGeo(args.Key)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	var removed int64
+	for _, member := range args.Members {
+		if geo.Remove(member) {
+			removed++
+		}
+	}
+	if removed > 0 {
+		c.notify(pb.EventType_UPDATE, args.Key)
+	}
+	c.mu.Unlock()
+
+	return &pb.Count{
+		Count: removed,
+	}, nil
+}
+
+func (c *cache) GeoPos(ctx context.Context, args *pb.GeoMembersRequest) (*pb.GeoPositions, error) {
+	c.mu.RLock()
+	geo, err := c.getGeo(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+
+	res := &pb.GeoPositions{}
+	for _, member := range args.Members {
+		p, exists := geo.Members[member]
+		res.Positions = append(res.Positions, &pb.GeoPosition{
+			Member:    member,
+			Longitude: p.Lon,
+			Latitude:  p.Lat,
+			Exists:    exists,
+		})
+	}
+	c.mu.RUnlock()
+
+	return res, nil
+}
+
+func (c *cache) GeoDist(ctx context.Context, args *pb.GeoDistRequest) (*pb.GeoDistance, error) {
+	unit, err := unitMeters(args.Unit)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	geo, err := c.getGeo(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+
+	p1, ok1 := geo.Members[args.Member1]
+	p2, ok2 := geo.Members[args.Member2]
+	c.mu.RUnlock()
+	if !ok1 || !ok2 {
+		return nil, ErrNoMember
+	}
+
+	return &pb.GeoDistance{
+		Distance: dt.GeoDistance(p1.Lon, p1.Lat, p2.Lon, p2.Lat) / unit,
+	}, nil
+}
+
+// GeoSearch returns the members within a radius or a box around a member
+// or a point, sorted by distance and limited to args.Count if it is set.
+// Distances, radius and box are in args.Unit.
+func (c *cache) GeoSearch(ctx context.Context, args *pb.GeoSearchRequest) (*pb.GeoResults, error) {
+	unit, err := unitMeters(args.Unit)
+	if err != nil {
+		return nil, err
+	}
+	if args.Center == nil || args.Shape == nil {
+		return nil, ErrInvalidShape
+	}
+
+	c.mu.RLock()
+	geo, err := c.getGeo(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+
+	var lon, lat float64
+	switch center := args.Center.(type) {
+	case *pb.GeoSearchRequest_Member:
+		p, ok := geo.Members[center.Member]
+		if !ok {
+			c.mu.RUnlock()
+			return nil, ErrNoMember
+		}
+		lon, lat = p.Lon, p.Lat
+	case *pb.GeoSearchRequest_Point:
+		lon, lat = center.Point.Longitude, center.Point.Latitude
+		if !dt.ValidGeoPoint(lon, lat) {
+			c.mu.RUnlock()
+			return nil, ErrInvalidCoords
+		}
+	}
+
+	var matches []dt.GeoMatch
+	switch shape := args.Shape.(type) {
+	case *pb.GeoSearchRequest_Radius:
+		matches = geo.SearchRadius(lon, lat, shape.Radius*unit)
+	case *pb.GeoSearchRequest_Box:
+		matches = geo.SearchBox(lon, lat, shape.Box.Width*unit, shape.Box.Height*unit)
+	}
+	c.mu.RUnlock()
+
+	dt.SortGeoMatches(matches, args.Desc)
+	if args.Count > 0 && int64(len(matches)) > args.Count {
+		matches = matches[:args.Count]
+	}
+
+	res := &pb.GeoResults{}
+	for _, m := range matches {
+		res.Results = append(res.Results, &pb.GeoResult{
+			Member:    m.Member,
+			Distance:  m.Distance / unit,
+			Longitude: m.Point.Lon,
+			Latitude:  m.Point.Lat,
+		})
+	}
+	return res, nil
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
+)
+
+// getHyperLogLog returns the HyperLogLog at key. Must be called with c.mu
+// held.
+func (c *cache) getHyperLogLog(key string) (*dt.HyperLogLogT, error) {
+	kr := genKeyReport(c, key, hllType)
+	if !kr.exists {
+		return nil, ErrNoKey
+	}
+	if !kr.typeMatch {
+		return nil, ErrWrongType
+	}
+
+	hll := (kr.val).(*dt.HyperLogLogT)
+	if isExpired(hll.Expiration) {
+		return nil, ErrKeyExpired
+	}
+	return hll, nil
+}
+
+// createHyperLogLog returns the HyperLogLog at key, creating it if it does
+// not exist. Must be called with c.mu held.
+func (c *cache) createHyperLogLog(key string, expiration int64) (*dt.HyperLogLogT, bool, error) {
+	hll, err := c.getHyperLogLog(key)
+	if err != ErrNoKey {
+		return hll, false, err
+	}
+
+	hll = dt.NewHyperLogLog(expiration)
+	c.store.Insert(key, dt.AnyT(hll))
+	c.expList[key] = expiration
+	return hll, true, nil
+}
+
+// PFAdd adds elements to the HyperLogLog at key. Response is true if the
+// estimated cardinality may have changed.
+func (c *cache) PFAdd(ctx context.Context, args *pb.HLLAddRequest) (*pb.Response, error) {
+	c.mu.Lock()
+	hll, created, err := c.createHyperLogLog(args.Key, getExpiration(args.Expiration))
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	changed := created
+	for _, e := range args.Elements {
+		if hll.Add(e) {
+			changed = true
+		}
+	}
+	if changed {
+		c.notify(pb.EventType_UPDATE, args.Key)
+	}
+	c.mu.Unlock()
+
+	return &pb.Response{
+		Response: changed,
+	}, nil
+}
+
+// PFCount returns the estimated cardinality of the union of the
+// HyperLogLogs at keys. Missing keys count as empty.
+func (c *cache) PFCount(ctx context.Context, args *pb.Keys) (*pb.Count, error) {
+	c.mu.RLock()
+	union := dt.NewHyperLogLog(0)
+	for _, key := range args.Keys {
+		hll, err := c.getHyperLogLog(key)
+		if err == ErrNoKey || err == ErrKeyExpired {
+			continue
+		}
+		if err != nil {
+			c.mu.RUnlock()
+			return nil, err
+		}
+		if len(args.Keys) == 1 {
+			union = hll
+			break
+		}
+		union.Merge(hll)
+	}
+	count := union.Count()
+	c.mu.RUnlock()
+
+	return &pb.Count{
+		Count: int64(count),
+	}, nil
+}
+
+// PFMerge merges the HyperLogLogs at sources into dest, creating it if it
+// does not exist.
+func (c *cache) PFMerge(ctx context.Context, args *pb.HLLMergeRequest) (*pb.Response, error) {
+	c.mu.Lock()
+	var sources []*dt.HyperLogLogT
+	for _, key := range args.Sources {
+		hll, err := c.getHyperLogLog(key)
+		if err == ErrNoKey || err == ErrKeyExpired {
+			continue
+		}
+		if err != nil {
+			c.mu.Unlock()
+			return nil, err
+		}
+		sources = append(sources, hll)
+	}
+
+	dest, _, err := c.createHyperLogLog(args.Dest, getExpiration(args.Expiration))
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+	for _, hll := range sources {
+		dest.Merge(hll)
+	}
+	c.notify(pb.EventType_UPDATE, args.Dest)
+	c.mu.Unlock()
+
+	return &pb.Response{
+		Response: true,
+	}, nil
+}
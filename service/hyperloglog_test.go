@@ -0,0 +1,82 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
+)
+
+// restored returns a new cache holding a snapshot of c.
+func restored(t *testing.T, c *Cache) *Cache {
+	t.Helper()
+	data, err := c.SnapshotState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := NewCacheService(time.Minute, time.Minute)
+	if err := r.RestoreState(data); err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestHyperLogLogSnapshot(t *testing.T) {
+	ctx := context.Background()
+	c := NewCacheService(time.Minute, time.Minute)
+	var elements []string
+	for i := 0; i < 10000; i++ {
+		elements = append(elements, fmt.Sprint("visitor", i))
+	}
+	// sparse and full stay in the sparse representation, dense does not.
+	for key, n := range map[string]int{"sparse": 100, "full": 2800, "dense": len(elements)} {
+		if _, err := c.PFAdd(ctx, &pb.HLLAddRequest{Key: key, Elements: elements[:n]}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The sparse representation must take less room than the dense one,
+	// even close to the switch.
+	size := make(map[string]int)
+	for _, key := range []string{"sparse", "full", "dense"} {
+		v, _ := c.store.Find(key)
+		if sparse := v.(*dt.HyperLogLogT).IsSparse(); sparse != (key != "dense") {
+			t.Fatalf("%s: sparse is %v", key, sparse)
+		}
+		data, err := dt.Encode(v.(dt.AnyT))
+		if err != nil {
+			t.Fatal(err)
+		}
+		size[key] = len(data)
+	}
+	if size["full"] >= size["dense"] {
+		t.Errorf("sparse sketch encoded in %d bytes, dense in %d", size["full"], size["dense"])
+	}
+
+	r := restored(t, c)
+	for _, key := range []string{"sparse", "full", "dense"} {
+		want, err := c.PFCount(ctx, &pb.Keys{Keys: []string{key}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := r.PFCount(ctx, &pb.Keys{Keys: []string{key}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.Count != want.Count {
+			t.Errorf("%s: count %d after restore, want %d", key, got.Count, want.Count)
+		}
+	}
+
+	// The restored sketch goes on counting.
+	if _, err := r.PFAdd(ctx, &pb.HLLAddRequest{Key: "sparse", Elements: []string{"new"}}); err != nil {
+		t.Fatal(err)
+	}
+	got, _ := r.PFCount(ctx, &pb.Keys{Keys: []string{"sparse"}})
+	if got.Count != 101 {
+		t.Errorf("count %d after adding to the restored sketch, want 101", got.Count)
+	}
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
+)
+
+var (
+	ErrIndexExists  = errors.New("Index already exists")
+	ErrNoIndex      = errors.New("No such index")
+	ErrInvalidIndex = errors.New("Invalid index definition")
+	ErrInvalidQuery = errors.New("Condition does not match the field type")
+	ErrNoField      = errors.New("Field is not indexed")
+)
+
+const defaultQueryLimit = 10
+
+// indexKey brings the indexes covering key up to date with its current
+// value. Must be called with c.mu held after every change to a hash or a
+// string.
+func (c *cache) indexKey(key string) {
+	value, _ := c.store.Find(key)
+	var data map[string]string
+	if hashMap, ok := value.(*dt.HashMapT); ok {
+		data = hashMap.Data
+	}
+	for _, idx := range c.indexes {
+		if idx.Matches(key) {
+			idx.Update(key, data)
+		}
+	}
+	for _, idx := range c.searchIndexes {
+		if idx.Matches(key) {
+			idx.Update(key, searchTexts(idx, value))
+		}
+	}
+}
+
+func (c *cache) CreateIndex(ctx context.Context, args *pb.IndexDefinition) (*pb.Response, error) {
+	if args.Name == "" || len(args.Fields) == 0 {
+		return nil, ErrInvalidIndex
+	}
+	var fields []dt.IndexField
+	seen := make(map[string]bool)
+	for _, f := range args.Fields {
+		if f.Name == "" || seen[f.Name] {
+			return nil, ErrInvalidIndex
+		}
+		seen[f.Name] = true
+		fields = append(fields, dt.IndexField{Name: f.Name, Type: dt.IndexFieldType(f.Type)})
+	}
+
+	c.mu.Lock()
+	if _, exists := c.indexes[args.Name]; exists {
+		c.mu.Unlock()
+		return nil, ErrIndexExists
+	}
+
+	idx := dt.NewHashIndex(args.Name, args.Prefix, fields)
+	c.store.Ascend(args.Prefix, func(key string, value interface{}) bool {
+		if !idx.Matches(key) {
+			return false
+		}
+		if hashMap, ok := value.(*dt.HashMapT); ok {
+			idx.Update(key, hashMap.Data)
+		}
+		return true
+	})
+	c.indexes[args.Name] = idx
+	c.mu.Unlock()
+
+	return &pb.Response{
+		Response: true,
+	}, nil
+}
+
+func (c *cache) DropIndex(ctx context.Context, args *pb.IndexName) (*pb.Response, error) {
+	c.mu.Lock()
+	if _, exists := c.indexes[args.Name]; !exists {
+		c.mu.Unlock()
+		return nil, ErrNoIndex
+	}
+	delete(c.indexes, args.Name)
+	c.mu.Unlock()
+
+	return &pb.Response{
+		Response: true,
+	}, nil
+}
+
+func indexDefinition(idx *dt.HashIndex) *pb.IndexDefinition {
+	def := &pb.IndexDefinition{
+		Name:   idx.Name,
+		Prefix: idx.Prefix,
+	}
+	for _, f := range idx.Fields {
+		def.Fields = append(def.Fields, &pb.IndexField{
+			Name: f.Name,
+			Type: pb.IndexFieldType(f.Type),
+		})
+	}
+	return def
+}
+
+func (c *cache) IndexInfo(ctx context.Context, args *pb.IndexName) (*pb.IndexInfoResult, error) {
+	c.mu.RLock()
+	idx, exists := c.indexes[args.Name]
+	if !exists {
+		c.mu.RUnlock()
+		return nil, ErrNoIndex
+	}
+
+	def := indexDefinition(idx)
+	keys := int64(idx.Len())
+	c.mu.RUnlock()
+
+	return &pb.IndexInfoResult{
+		Definition: def,
+		Keys:       keys,
+	}, nil
+}
+
+// conditionKeys returns the keys of idx matching cond.
+func conditionKeys(idx *dt.HashIndex, cond *pb.IndexCondition) (map[string]bool, error) {
+	field, ok := idx.Field(cond.Field)
+	if !ok {
+		return nil, ErrNoField
+	}
+
+	switch m := cond.Match.(type) {
+	case *pb.IndexCondition_Tag:
+		if field.Type != dt.IndexTag {
+			return nil, ErrInvalidQuery
+		}
+		return idx.TagKeys(field.Name, m.Tag.Values), nil
+	case *pb.IndexCondition_Range:
+		if field.Type != dt.IndexNumeric {
+			return nil, ErrInvalidQuery
+		}
+		min, max := m.Range.Min, m.Range.Max
+		if m.Range.NoMin {
+			min = math.Inf(-1)
+		}
+		if m.Range.NoMax {
+			max = math.Inf(1)
+		}
+		return idx.RangeKeys(field.Name, min, max, m.Range.ExclusiveMin, m.Range.ExclusiveMax), nil
+	}
+	return nil, ErrInvalidQuery
+}
+
+// QueryIndex returns the keys of the index matching every condition,
+// sorted by args.SortBy or by key, along with the number of matching keys.
+// Keys missing the sort field come last.
+func (c *cache) QueryIndex(ctx context.Context, args *pb.IndexQuery) (*pb.IndexQueryResult, error) {
+	c.mu.RLock()
+	idx, exists := c.indexes[args.Index]
+	if !exists {
+		c.mu.RUnlock()
+		return nil, ErrNoIndex
+	}
+	sortField, sortByField := idx.Field(args.SortBy)
+	if args.SortBy != "" && !sortByField {
+		c.mu.RUnlock()
+		return nil, ErrNoField
+	}
+
+	var matches map[string]bool
+	for _, cond := range args.Conditions {
+		keys, err := conditionKeys(idx, cond)
+		if err != nil {
+			c.mu.RUnlock()
+			return nil, err
+		}
+		if matches == nil {
+			matches = keys
+			continue
+		}
+		for key := range matches {
+			if !keys[key] {
+				delete(matches, key)
+			}
+		}
+	}
+	if matches == nil {
+		matches = make(map[string]bool)
+		for key := range idx.Docs {
+			matches[key] = true
+		}
+	}
+
+	now := time.Now().UnixNano()
+	type result struct {
+		key    string
+		value  string
+		number float64
+		has    bool
+	}
+	var results []result
+	for key := range matches {
+		if exp := c.expList[key]; exp > 0 && now > exp {
+			continue
+		}
+		r := result{key: key}
+		if sortByField {
+			r.value, r.has = idx.Docs[key][sortField.Name]
+			if r.has && sortField.Type == dt.IndexNumeric {
+				r.number, r.has = dt.ParseNumeric(r.value)
+			}
+		}
+		results = append(results, r)
+	}
+	c.mu.RUnlock()
+
+	sort.Slice(results, func(i, j int) bool {
+		a, b := results[i], results[j]
+		if a.has != b.has {
+			return a.has
+		}
+		less, equal := a.key < b.key, a.key == b.key
+		if a.has {
+			if sortField.Type == dt.IndexNumeric {
+				less, equal = a.number < b.number, a.number == b.number
+			} else {
+				less, equal = a.value < b.value, a.value == b.value
+			}
+		}
+		if equal {
+			return a.key < b.key
+		}
+		return less != args.Desc
+	})
+
+	res := &pb.IndexQueryResult{
+		Total: int64(len(results)),
+	}
+	limit := args.Limit
+	if limit <= 0 {
+		limit = defaultQueryLimit
+	}
+	for i := args.Offset; i >= 0 && i < int64(len(results)) && i < args.Offset+limit; i++ {
+		res.Keys = append(res.Keys, results[i].key)
+	}
+	return res, nil
+}
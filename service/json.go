@@ -0,0 +1,365 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
+)
+
+// getJSON returns the JSON document at key. Must be called with c.mu held.
+func (c *cache) getJSON(key string) (*dt.JSONT, error) {
+	kr := genKeyReport(c, key, jsonType)
+	if !kr.exists {
+		return nil, ErrNoKey
+	}
+	if !kr.typeMatch {
+		return nil, ErrWrongType
+	}
+
+	doc := (kr.val).(*dt.JSONT)
+	if isExpired(doc.Expiration) {
+		return nil, ErrKeyExpired
+	}
+	return doc, nil
+}
+
+// updateJSON applies fn to the value at path of the document at key. Must
+// be called with c.mu held.
+func (c *cache) updateJSON(key, path string, fn dt.UpdateFunc) error {
+	elems, err := dt.ParseJSONPath(path)
+	if err != nil {
+		return err
+	}
+	doc, err := c.getJSON(key)
+	if err != nil {
+		return err
+	}
+	if err := doc.Update(elems, fn); err != nil {
+		return err
+	}
+	c.notify(pb.EventType_UPDATE, key)
+	return nil
+}
+
+// JSONSet sets the value at path. A document that does not exist can only
+// be created at the root. Response is false if the NX or XX condition was
+// not met.
+func (c *cache) JSONSet(ctx context.Context, args *pb.JSONSetRequest) (*pb.Response, error) {
+	elems, err := dt.ParseJSONPath(args.Path)
+	if err != nil {
+		return nil, err
+	}
+	value, err := dt.DecodeJSON(args.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	doc, err := c.getJSON(args.Key)
+	if err == ErrNoKey && len(elems) == 0 {
+		if args.Mode == pb.SetMode_XX {
+			c.mu.Unlock()
+			return &pb.Response{Response: false}, nil
+		}
+		expiration := getExpiration(args.Expiration)
+		c.store.Insert(args.Key, dt.AnyT(&dt.JSONT{Root: value, Expiration: expiration}))
+		c.expList[args.Key] = expiration
+		c.notify(pb.EventType_UPDATE, args.Key)
+		c.mu.Unlock()
+		return &pb.Response{Response: true}, nil
+	}
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	set := true
+	err = doc.Update(elems, func(old interface{}, exists bool) (interface{}, bool, error) {
+		if (args.Mode == pb.SetMode_NX && exists) || (args.Mode == pb.SetMode_XX && !exists) {
+			set = false
+			return old, !exists, nil
+		}
+		return value, false, nil
+	})
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+	if set {
+		c.notify(pb.EventType_UPDATE, args.Key)
+	}
+	c.mu.Unlock()
+
+	return &pb.Response{
+		Response: set,
+	}, nil
+}
+
+func (c *cache) JSONGet(ctx context.Context, args *pb.JSONPathRequest) (*pb.JSONValue, error) {
+	elems, err := dt.ParseJSONPath(args.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	doc, err := c.getJSON(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+	v, err := doc.Get(elems)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+	value := dt.EncodeJSON(v)
+	c.mu.RUnlock()
+
+	return &pb.JSONValue{
+		Value: value,
+	}, nil
+}
+
+// JSONDel deletes the value at path, or the whole key at the root, and
+// returns the number of values deleted.
+func (c *cache) JSONDel(ctx context.Context, args *pb.JSONPathRequest) (*pb.Count, error) {
+	elems, err := dt.ParseJSONPath(args.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	if _, err := c.getJSON(args.Key); err != nil {
+		c.mu.Unlock()
+		if err == ErrNoKey {
+			return &pb.Count{}, nil
+		}
+		return nil, err
+	}
+
+	var deleted int64
+	if len(elems) == 0 {
+		c.store.Delete(args.Key)
+		delete(c.expList, args.Key)
+		c.notify(pb.EventType_DELETE, args.Key)
+		deleted = 1
+	} else {
+		err = c.updateJSON(args.Key, args.Path, func(old interface{}, exists bool) (interface{}, bool, error) {
+			if exists {
+				deleted = 1
+			}
+			return nil, true, nil
+		})
+		if err == dt.ErrJSONNoPath {
+			err = nil
+		}
+	}
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Count{
+		Count: deleted,
+	}, nil
+}
+
+// JSONNumIncrBy adds args.Value to the number at path and returns the new
+// number.
+func (c *cache) JSONNumIncrBy(ctx context.Context, args *pb.JSONNumRequest) (*pb.JSONValue, error) {
+	var res json.Number
+	c.mu.Lock()
+	err := c.updateJSON(args.Key, args.Path, func(old interface{}, exists bool) (interface{}, bool, error) {
+		n, ok := old.(json.Number)
+		if !exists || !ok {
+			return nil, false, dt.ErrJSONType
+		}
+		sum, err := dt.AddNumbers(n, args.Value)
+		res = sum
+		return sum, false, err
+	})
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.JSONValue{
+		Value: res.String(),
+	}, nil
+}
+
+func decodeJSONValues(values []string) ([]interface{}, error) {
+	var res []interface{}
+	for _, s := range values {
+		v, err := dt.DecodeJSON(s)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, v)
+	}
+	return res, nil
+}
+
+// updateJSONArray applies fn to the array at path and returns its new
+// length. Must be called with c.mu held.
+func (c *cache) updateJSONArray(key, path string, fn func(arr []interface{}) ([]interface{}, error)) (int64, error) {
+	var length int64
+	err := c.updateJSON(key, path, func(old interface{}, exists bool) (interface{}, bool, error) {
+		arr, ok := old.([]interface{})
+		if !exists || !ok {
+			return nil, false, dt.ErrJSONType
+		}
+		arr, err := fn(arr)
+		length = int64(len(arr))
+		return arr, false, err
+	})
+	return length, err
+}
+
+// JSONArrAppend appends values to the array at path and returns its new
+// length.
+func (c *cache) JSONArrAppend(ctx context.Context, args *pb.JSONArrRequest) (*pb.Count, error) {
+	values, err := decodeJSONValues(args.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	length, err := c.updateJSONArray(args.Key, args.Path, func(arr []interface{}) ([]interface{}, error) {
+		return append(arr, values...), nil
+	})
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Count{
+		Count: length,
+	}, nil
+}
+
+// JSONArrInsert inserts values before args.Index of the array at path and
+// returns its new length. Negative indexes count from the end and an
+// index equal to the length appends.
+func (c *cache) JSONArrInsert(ctx context.Context, args *pb.JSONArrRequest) (*pb.Count, error) {
+	values, err := decodeJSONValues(args.Values)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	length, err := c.updateJSONArray(args.Key, args.Path, func(arr []interface{}) ([]interface{}, error) {
+		i := int(args.Index)
+		if i < 0 {
+			i += len(arr)
+		}
+		if i < 0 || i > len(arr) {
+			return nil, dt.ErrJSONRange
+		}
+		res := make([]interface{}, 0, len(arr)+len(values))
+		res = append(res, arr[:i]...)
+		res = append(res, values...)
+		return append(res, arr[i:]...), nil
+	})
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Count{
+		Count: length,
+	}, nil
+}
+
+// JSONArrPop removes and returns the element at args.Index of the array at
+// path. Negative indexes count from the end, so -1 pops the last element.
+func (c *cache) JSONArrPop(ctx context.Context, args *pb.JSONArrRequest) (*pb.JSONValue, error) {
+	var popped interface{}
+	c.mu.Lock()
+	_, err := c.updateJSONArray(args.Key, args.Path, func(arr []interface{}) ([]interface{}, error) {
+		i := int(args.Index)
+		if i < 0 {
+			i += len(arr)
+		}
+		if i < 0 || i >= len(arr) {
+			return nil, dt.ErrJSONRange
+		}
+		popped = arr[i]
+		return append(arr[:i:i], arr[i+1:]...), nil
+	})
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.JSONValue{
+		Value: dt.EncodeJSON(popped),
+	}, nil
+}
+
+func (c *cache) JSONType(ctx context.Context, args *pb.JSONPathRequest) (*pb.JSONValue, error) {
+	elems, err := dt.ParseJSONPath(args.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	doc, err := c.getJSON(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+	v, err := doc.Get(elems)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+	typ := dt.TypeName(v)
+	c.mu.RUnlock()
+
+	return &pb.JSONValue{
+		Value: typ,
+	}, nil
+}
+
+// JSONLen returns the length of the string, array or object at path.
+func (c *cache) JSONLen(ctx context.Context, args *pb.JSONPathRequest) (*pb.Count, error) {
+	elems, err := dt.ParseJSONPath(args.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	doc, err := c.getJSON(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+	v, err := doc.Get(elems)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+
+	var length int
+	switch n := v.(type) {
+	case string:
+		length = len(n)
+	case []interface{}:
+		length = len(n)
+	case map[string]interface{}:
+		length = len(n)
+	default:
+		err = dt.ErrJSONType
+	}
+	c.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Count{
+		Count: int64(length),
+	}, nil
+}
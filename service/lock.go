@@ -0,0 +1,186 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
+)
+
+var (
+	ErrNotLockOwner = errors.New("Lock is not held by owner")
+)
+
+func newOwnerToken() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// leaseExpiration returns the expiration for a lease of ttl, falling back
+// to the default expiration of the cache.
+func (c *cache) leaseExpiration(ttl string) int64 {
+	duration, _ := time.ParseDuration(ttl)
+	if duration <= 0 {
+		duration = c.defaultExpiration
+	}
+	return time.Now().Add(duration).UnixNano()
+}
+
+// tryAcquire takes the lock at key if it is free or its lease has run out.
+// It returns the current holder when the lock is taken. Must be called
+// with c.mu held.
+func (c *cache) tryAcquire(args *pb.LockRequest) (*dt.LockT, bool, error) {
+	kr := genKeyReport(c, args.Key, lockType)
+	if kr.exists && !kr.typeMatch {
+		return nil, false, ErrWrongType
+	}
+
+	var lock *dt.LockT
+	if kr.exists {
+		lock = (kr.val).(*dt.LockT)
+		if !isExpired(lock.Expiration) {
+			return lock, false, nil
+		}
+	}
+
+	c.fence++
+	expiration := c.leaseExpiration(args.Ttl)
+	if lock == nil {
+		lock = &dt.LockT{}
+		c.store.Insert(args.Key, dt.AnyT(lock))
+	}
+	lock.Owner = args.Owner
+	lock.Token = c.fence
+	lock.Expiration = expiration
+	c.expList[args.Key] = expiration
+	c.notify(pb.EventType_SET, args.Key)
+
+	return lock, true, nil
+}
+
+func (c *cache) AcquireLock(ctx context.Context, args *pb.LockRequest) (*pb.Lock, error) {
+	if args.Owner == "" {
+		args.Owner = newOwnerToken()
+	}
+
+	wait, _ := time.ParseDuration(args.Wait)
+	deadline := time.Now().Add(wait)
+
+	for {
+		c.mu.Lock()
+		lock, acquired, err := c.tryAcquire(args)
+		if err != nil {
+			c.mu.Unlock()
+			return nil, err
+		}
+		if acquired {
+			c.mu.Unlock()
+			return &pb.Lock{
+				Key:        args.Key,
+				Owner:      lock.Owner,
+				Token:      lock.Token,
+				Expiration: time.Unix(0, lock.Expiration).String(),
+				Acquired:   true,
+			}, nil
+		}
+
+		now := time.Now()
+		if !now.Before(deadline) {
+			c.mu.Unlock()
+			return &pb.Lock{
+				Key:   args.Key,
+				Owner: args.Owner,
+			}, nil
+		}
+
+		// Sleep until the lock is released, the current lease runs out or
+		// the wait deadline passes, whichever comes first.
+		released := c.waiter(args.Key)
+		sleep := deadline.Sub(now)
+		if lease := time.Unix(0, lock.Expiration).Sub(now); lease < sleep {
+			sleep = lease
+		}
+		c.mu.Unlock()
+
+		timer := time.NewTimer(sleep)
+		select {
+		case <-released:
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+		timer.Stop()
+	}
+}
+
+// heldLock returns the lock at key if it is still held by owner. Must be
+// called with c.mu held.
+func (c *cache) heldLock(key, owner string) (*dt.LockT, error) {
+	kr := genKeyReport(c, key, lockType)
+	if !kr.exists {
+		return nil, ErrNoKey
+	}
+	if !kr.typeMatch {
+		return nil, ErrWrongType
+	}
+
+	lock := (kr.val).(*dt.LockT)
+	if isExpired(lock.Expiration) {
+		return nil, ErrKeyExpired
+	}
+	if lock.Owner != owner {
+		return nil, ErrNotLockOwner
+	}
+	return lock, nil
+}
+
+func (c *cache) RenewLock(ctx context.Context, args *pb.LockRequest) (*pb.Lock, error) {
+	c.mu.Lock()
+	lock, err := c.heldLock(args.Key, args.Owner)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	lock.Expiration = c.leaseExpiration(args.Ttl)
+	c.expList[args.Key] = lock.Expiration
+	c.notify(pb.EventType_SET, args.Key)
+	c.mu.Unlock()
+
+	return &pb.Lock{
+		Key:        args.Key,
+		Owner:      lock.Owner,
+		Token:      lock.Token,
+		Expiration: time.Unix(0, lock.Expiration).String(),
+		Acquired:   true,
+	}, nil
+}
+
+func (c *cache) ReleaseLock(ctx context.Context, args *pb.Lock) (*pb.Response, error) {
+	c.mu.Lock()
+	lock, err := c.heldLock(args.Key, args.Owner)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+	if args.Token != 0 && lock.Token != args.Token {
+		c.mu.Unlock()
+		return nil, ErrNotLockOwner
+	}
+
+	c.store.Delete(args.Key)
+	delete(c.expList, args.Key)
+	c.wakeWaiters(args.Key)
+	c.notify(pb.EventType_DELETE, args.Key)
+	c.mu.Unlock()
+
+	return &pb.Response{
+		Response: true,
+	}, nil
+}
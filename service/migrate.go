@@ -0,0 +1,167 @@
+package service
+
+import (
+	"time"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
+)
+
+// Exists reports whether key holds a value that has not expired.
+func (c *cache) Exists(key string) bool {
+	c.mu.RLock()
+	v, exists := c.store.Find(key)
+	if exists && isExpired(dt.Expiration(v)) {
+		exists = false
+	}
+	c.mu.RUnlock()
+	return exists
+}
+
+// ScanKeys calls fn for every key in order until fn returns false. fn must
+// not call the cache.
+func (c *cache) ScanKeys(fn func(key string) bool) {
+	c.mu.RLock()
+	c.store.Ascend("", func(key string, _ interface{}) bool {
+		return fn(key)
+	})
+	c.mu.RUnlock()
+}
+
+// entry encodes the value at key along with its remaining time to live.
+// Must be called with c.mu held.
+func (c *cache) entry(key string) (*pb.KeyEntry, bool, error) {
+	v, exists := c.store.Find(key)
+	if !exists {
+		return nil, false, nil
+	}
+	var ttl int64
+	if exp := dt.Expiration(v); exp > 0 {
+		ttl = time.Duration(exp - time.Now().UnixNano()).Milliseconds()
+		if ttl <= 0 {
+			return nil, false, nil
+		}
+	}
+
+	data, err := dt.Encode(v)
+	if err != nil {
+		return nil, false, err
+	}
+	return &pb.KeyEntry{
+		Key:   key,
+		Value: data,
+		TtlMs: ttl,
+	}, true, nil
+}
+
+// Entries encodes the values at keys, skipping missing and expired keys.
+func (c *cache) Entries(keys []string) ([]*pb.KeyEntry, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var entries []*pb.KeyEntry
+	for _, key := range keys {
+		e, ok, err := c.entry(key)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+// MigrateKeys hands the given keys to send and evicts them once send
+// succeeds. The cache is not locked while send runs, and keys written
+// meanwhile are kept, to be sent again by the next scan of the migration.
+// Missing keys are skipped and expired keys are deleted without being
+// sent, so that they are not found again by the next scan.
+func (c *cache) MigrateKeys(keys []string, send func([]*pb.KeyEntry) error) (int, error) {
+	c.mu.Lock()
+	var entries []*pb.KeyEntry
+	for _, key := range keys {
+		e, ok, err := c.entry(key)
+		if err != nil {
+			for _, e := range entries {
+				delete(c.sending, e.Key)
+			}
+			c.mu.Unlock()
+			return 0, err
+		}
+		if ok {
+			entries = append(entries, e)
+			c.sending[key] = true
+		} else if _, exists := c.store.Find(key); exists {
+			c.expire(key)
+		}
+	}
+	c.mu.Unlock()
+	if len(entries) == 0 {
+		return 0, nil
+	}
+
+	err := send(entries)
+
+	moved := 0
+	c.mu.Lock()
+	for _, e := range entries {
+		if err == nil && c.sending[e.Key] {
+			c.store.Delete(e.Key)
+			delete(c.expList, e.Key)
+			c.indexKey(e.Key)
+			c.wakeWaiters(e.Key)
+			c.notify(pb.EventType_EVICT, e.Key)
+			moved++
+		}
+		delete(c.sending, e.Key)
+	}
+	c.mu.Unlock()
+	if err != nil {
+		return 0, err
+	}
+
+	return moved, nil
+}
+
+// RestoreKeys stores entries encoded by MigrateKeys and returns the number
+// of keys restored. Existing keys are only replaced if replace is set.
+func (c *cache) RestoreKeys(entries []*pb.KeyEntry, replace bool) (int, error) {
+	values := make([]dt.AnyT, len(entries))
+	for i, e := range entries {
+		v, err := dt.Decode(e.Value)
+		if err != nil {
+			return 0, err
+		}
+		values[i] = v
+	}
+
+	now := time.Now().UnixNano()
+	restored := 0
+	c.mu.Lock()
+	for i, e := range entries {
+		if _, exists := c.store.Find(e.Key); exists && !replace {
+			continue
+		}
+		c.putEntry(e, values[i], now)
+		restored++
+	}
+	c.mu.Unlock()
+
+	return restored, nil
+}
+
+// putEntry stores the decoded value of e, expiring after the time to live
+// of e from now. Must be called with c.mu held.
+func (c *cache) putEntry(e *pb.KeyEntry, value dt.AnyT, now int64) {
+	c.store.Delete(e.Key)
+	var expiration int64
+	if e.TtlMs > 0 {
+		expiration = now + (time.Duration(e.TtlMs) * time.Millisecond).Nanoseconds()
+	}
+	dt.SetExpiration(value, expiration)
+	c.store.Insert(e.Key, value)
+	c.expList[e.Key] = expiration
+	c.indexKey(e.Key)
+	c.wakeWaiters(e.Key)
+	c.notify(pb.EventType_UPDATE, e.Key)
+}
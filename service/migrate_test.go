@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/shanukun/cash/cash_proto"
+	"github.com/shanukun/cash/cluster"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func TestMigrateKeysDeletesExpired(t *testing.T) {
+	ctx := context.Background()
+	c := NewCacheService(time.Minute, time.Minute)
+	if _, err := c.Set(ctx, &pb.String{Key: "kept", Value: "1"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Set(ctx, &pb.String{Key: "expired", Value: "2", Expiration: "1ms"}); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	var sent []string
+	moved, err := c.MigrateKeys([]string{"kept", "expired", "missing"}, func(entries []*pb.KeyEntry) error {
+		for _, e := range entries {
+			sent = append(sent, e.Key)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if moved != 1 || len(sent) != 1 || sent[0] != "kept" {
+		t.Fatalf("moved %d keys %v, want only kept", moved, sent)
+	}
+
+	// A migration rescans until no key is left in its slots.
+	var left []string
+	c.ScanKeys(func(key string) bool {
+		left = append(left, key)
+		return true
+	})
+	if len(left) != 0 {
+		t.Fatalf("keys %v left after migration", left)
+	}
+}
+
+func TestMigrateKeysEvicts(t *testing.T) {
+	ctx := context.Background()
+	c := NewCacheService(time.Minute, time.Minute)
+	if _, err := c.Set(ctx, &pb.String{Key: "moved", Value: "1"}); err != nil {
+		t.Fatal(err)
+	}
+	w, err := c.watch.watch(&pb.WatchRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.watch.unwatch(w)
+
+	if _, err := c.MigrateKeys([]string{"moved"}, func([]*pb.KeyEntry) error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case ev := <-w.events:
+		if ev.Type != pb.EventType_EVICT || ev.Key != "moved" {
+			t.Errorf("migration emitted %v %s, want EVICT moved", ev.Type, ev.Key)
+		}
+	default:
+		t.Error("migration emitted no event")
+	}
+}
+
+// clusterNode is a cache served over gRPC in cluster mode.
+type clusterNode struct {
+	address string
+	cache   *Cache
+	cluster *cluster.Cluster
+}
+
+func newClusterNode(t *testing.T, id string) *clusterNode {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := &clusterNode{address: lis.Addr().String(), cache: NewCacheService(time.Minute, time.Minute)}
+	n.cluster = cluster.New(id, n.address, n.cache)
+	s := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(n.cluster.UnaryInterceptor()),
+		grpc.ChainStreamInterceptor(n.cluster.StreamInterceptor()))
+	pb.RegisterCacheServiceServer(s, n.cache)
+	pb.RegisterClusterServiceServer(s, n.cluster)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	return n
+}
+
+// clusterClient sends requests to the nodes of a cluster, following
+// redirects.
+type clusterClient struct {
+	mu    sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+func (cl *clusterClient) invoke(ctx context.Context, address, method string, req, resp interface{}) error {
+	asking := false
+	for {
+		cl.mu.Lock()
+		conn, ok := cl.conns[address]
+		if !ok {
+			var err error
+			conn, err = grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				cl.mu.Unlock()
+				return err
+			}
+			cl.conns[address] = conn
+		}
+		cl.mu.Unlock()
+
+		callCtx := ctx
+		if asking {
+			callCtx = cluster.Asking(ctx)
+		}
+		err := conn.Invoke(callCtx, "/CacheService/"+method, req, resp)
+		if r, ok := cluster.ParseRedirect(err); ok {
+			address, asking = r.Address, r.Ask
+			continue
+		}
+		if status.Code(err) == codes.Unavailable {
+			time.Sleep(time.Millisecond)
+			continue
+		}
+		return err
+	}
+}
+
+func TestMigrationWithWrites(t *testing.T) {
+	ctx := context.Background()
+	a, b := newClusterNode(t, "a"), newClusterNode(t, "b")
+	slots := &pb.SetSlotsRequest{
+		Nodes: []*pb.ClusterNode{{Id: "a", Address: a.address}, {Id: "b", Address: b.address}},
+		Slots: []*pb.SlotRange{{Start: 0, End: cluster.NumSlots - 1, Node: "a"}},
+		Epoch: 1,
+	}
+	for _, n := range []*clusterNode{a, b} {
+		if _, err := n.cluster.ClusterSetSlots(ctx, slots); err != nil {
+			t.Fatal(err)
+		}
+	}
+	client := &clusterClient{conns: make(map[string]*grpc.ClientConn)}
+	t.Cleanup(func() {
+		for _, conn := range client.conns {
+			conn.Close()
+		}
+	})
+
+	var keys []string
+	for i := 0; i < 500; i++ {
+		key := fmt.Sprint("key", i)
+		keys = append(keys, key)
+		if err := client.invoke(ctx, a.address, "LPush", &pb.String{Key: key, Value: "0"}, &pb.Response{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Every key is pushed to until the migration is done.
+	done := make(chan struct{})
+	pushes := make([]int, len(keys))
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for round := 1; ; round++ {
+				for i := w; i < len(keys); i += 8 {
+					select {
+					case <-done:
+						return
+					default:
+					}
+					if err := client.invoke(ctx, a.address, "LPush", &pb.String{Key: keys[i], Value: fmt.Sprint(round)}, &pb.Response{}); err != nil {
+						t.Error(err)
+						return
+					}
+					pushes[i]++
+				}
+			}
+		}(w)
+	}
+
+	if _, err := a.cluster.ClusterMigrate(ctx, &pb.MigrateRequest{Start: 0, End: cluster.NumSlots - 1, Target: "b"}); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		st, err := a.cluster.ClusterMigrationStatus(ctx, &empty.Empty{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if st.State == cluster.MigrationFailed {
+			t.Fatalf("migration failed: %s", st.Error)
+		}
+		if st.State == cluster.MigrationDone {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("migration not done after 10s: %v", st)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	close(done)
+	wg.Wait()
+
+	var left []string
+	a.cache.ScanKeys(func(key string) bool {
+		left = append(left, key)
+		return true
+	})
+	if len(left) != 0 {
+		t.Errorf("keys %v left on the source", left)
+	}
+	for i, key := range keys {
+		list, err := b.cache.GetList(ctx, &pb.Key{Key: key})
+		if err != nil {
+			t.Fatalf("%s: %v", key, err)
+		}
+		if len(list.List) != pushes[i]+1 {
+			t.Errorf("%s holds %d elements on the target, want %d", key, len(list.List), pushes[i]+1)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	"github.com/shanukun/cash/crdt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MultiMaster replicates the strings, counters, hashes, sets and lists of
+// a cache between sites which all accept writes, through a crdt.Node.
+// Writes run on the cache, then as operations on the CRDTs of their keys,
+// whose values replace the values of the cache. Reads and Publish stay
+// local; the other writes are not supported.
+type MultiMaster struct {
+	node *crdt.Node
+}
+
+// NewMultiMaster returns the site storing its keys in c, to be started
+// with Start.
+func NewMultiMaster(c *Cache, cfg crdt.Config) *MultiMaster {
+	cfg.Store = c.cache
+	return &MultiMaster{node: crdt.New(cfg)}
+}
+
+func (m *MultiMaster) Node() *crdt.Node {
+	return m.node
+}
+
+func (m *MultiMaster) Start() {
+	m.node.Start()
+}
+
+func (m *MultiMaster) Stop() {
+	m.node.Stop()
+}
+
+// multiMasterWrites are the writes supported in multi-master mode, as the
+// operations on CRDTs run once the cache has answered resp to req.
+var multiMasterWrites = map[string]func(n *crdt.Node, req, resp interface{}) error{
+	"Set": func(n *crdt.Node, req, _ interface{}) error {
+		r := req.(*pb.String)
+		return n.Assign(r.Key, stringBytes(r.Value, r.Raw), getExpiration(r.Expiration), false)
+	},
+	"GetSet": func(n *crdt.Node, req, _ interface{}) error {
+		r := req.(*pb.String)
+		return n.Assign(r.Key, stringBytes(r.Value, r.Raw), getExpiration(r.Expiration), false)
+	},
+	"SetCond": func(n *crdt.Node, req, resp interface{}) error {
+		r := req.(*pb.SetItem)
+		if !resp.(*pb.SetResult).Response {
+			return nil
+		}
+		return n.Assign(r.Key, []byte(r.Value), getExpiration(r.Expiration), r.KeepTtl)
+	},
+	"IncrBy": func(n *crdt.Node, req, _ interface{}) error {
+		r := req.(*pb.CounterRequest)
+		return n.Increment(r.Key, r.Delta, getExpiration(r.Expiration))
+	},
+	"DeleteKey": func(n *crdt.Node, req, _ interface{}) error {
+		return n.Delete(req.(*pb.Key).Key)
+	},
+	"LPush": func(n *crdt.Node, req, _ interface{}) error {
+		r := req.(*pb.String)
+		return n.Push(r.Key, true, []string{r.Value}, getExpiration(r.Expiration))
+	},
+	"RPush": func(n *crdt.Node, req, _ interface{}) error {
+		r := req.(*pb.String)
+		return n.Push(r.Key, false, []string{r.Value}, getExpiration(r.Expiration))
+	},
+	"HMSet": func(n *crdt.Node, req, _ interface{}) error {
+		r := req.(*pb.HashMapItem)
+		return n.SetFields(r.Key, map[string]string{r.Field: r.Value}, getExpiration(r.Expiration))
+	},
+	"SAdd": func(n *crdt.Node, req, _ interface{}) error {
+		r := req.(*pb.SetMembersRequest)
+		return n.AddMembers(r.Key, r.Members, getExpiration(r.Expiration))
+	},
+	"SRem": func(n *crdt.Node, req, _ interface{}) error {
+		r := req.(*pb.SetMembersRequest)
+		return n.RemoveMembers(r.Key, r.Members)
+	},
+	"DeleteAll": func(n *crdt.Node, _, _ interface{}) error {
+		return n.DeleteAll()
+	},
+}
+
+func (m *MultiMaster) handle(ctx context.Context, method string, req interface{}, handler grpc.UnaryHandler) (interface{}, error) {
+	name := strings.TrimPrefix(method, "/CacheService/")
+	if readOnlyMethods[name] || name == "Publish" {
+		return handler(ctx, req)
+	}
+	op, ok := multiMasterWrites[name]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "%s is not supported in multi-master mode", name)
+	}
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	// The cache ignores some writes to keys of another type, which the
+	// CRDTs then refuse.
+	if err := op(m.node, req, resp); err != nil && err != crdt.ErrWrongKind {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UnaryInterceptor replicates the writes to CacheService to the other
+// sites.
+func (m *MultiMaster) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, "/CacheService/") {
+			return handler(ctx, req)
+		}
+		return m.handle(ctx, info.FullMethod, req, handler)
+	}
+}
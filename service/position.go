@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shanukun/cash/cluster"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata of requests and responses.
+const (
+	// positionKey holds the position of the primary after a write, in the
+	// header of its response.
+	positionKey     = "cash-position"
+	minPositionKey  = "cash-min-position"
+	maxStalenessKey = "cash-max-staleness"
+)
+
+// defaultReadWait is how long a replica waits to catch up with a read
+// without deadline before forwarding it to its primary.
+const defaultReadWait = time.Second
+
+var (
+	ErrInvalidPosition = errors.New("Replication position must be replid:offset")
+	ErrNotInSync       = errors.New("Replica is not in sync with its primary")
+)
+
+// WithMinPosition returns a context for reads which must see the writes up
+// to position, as returned by Position, such as the writes of the same
+// client.
+func WithMinPosition(ctx context.Context, position string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, minPositionKey, position)
+}
+
+// WithMaxStaleness returns a context for reads which must see the writes
+// made on the primary more than d ago.
+func WithMaxStaleness(ctx context.Context, d time.Duration) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, maxStalenessKey, d.String())
+}
+
+// Position returns the replication position in the header of the response
+// to a write, received with grpc.Header, or "" if there is none.
+func Position(header metadata.MD) string {
+	if values := header.Get(positionKey); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// position returns the replid and offset of the node as a position.
+// Must be called with r.mu held.
+func (r *Replication) position() string {
+	return fmt.Sprintf("%s:%d", r.replid, r.offset)
+}
+
+func parsePosition(position string) (string, int64, error) {
+	replid, offset, ok := strings.Cut(position, ":")
+	if !ok {
+		return "", 0, ErrInvalidPosition
+	}
+	n, err := strconv.ParseInt(offset, 10, 64)
+	if err != nil {
+		return "", 0, ErrInvalidPosition
+	}
+	return replid, n, nil
+}
+
+// readNeeds holds the requirements of a read on the state of a replica.
+type readNeeds struct {
+	replid       string
+	offset       int64
+	maxStaleness time.Duration
+}
+
+func parseReadNeeds(ctx context.Context) (*readNeeds, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var needs *readNeeds
+	if values := md.Get(minPositionKey); len(values) > 0 {
+		replid, offset, err := parsePosition(values[0])
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		needs = &readNeeds{replid: replid, offset: offset}
+	}
+	if values := md.Get(maxStalenessKey); len(values) > 0 {
+		d, err := time.ParseDuration(values[0])
+		if err != nil || d <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid maximum staleness %q", values[0])
+		}
+		if needs == nil {
+			needs = &readNeeds{}
+		}
+		needs.maxStaleness = d
+	}
+	return needs, nil
+}
+
+// satisfies reports whether the replica meets needs. A position of
+// another history can not be compared, so it is never met.
+// Must be called with r.mu held.
+func (r *Replication) satisfies(needs *readNeeds) bool {
+	if needs.replid != "" && (needs.replid != r.replid || r.offset < needs.offset) {
+		return false
+	}
+	if needs.maxStaleness > 0 && (!r.linkUp || time.Since(r.syncedAt) > needs.maxStaleness) {
+		return false
+	}
+	return true
+}
+
+// await waits until the node is a primary or meets needs, and reports
+// whether it did. Reads with a deadline wait for half of the time left,
+// keeping the rest to be forwarded.
+func (r *Replication) await(ctx context.Context, needs *readNeeds) bool {
+	wait := defaultReadWait
+	if deadline, ok := ctx.Deadline(); ok {
+		wait = time.Until(deadline) / 2
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	for {
+		r.mu.Lock()
+		ok := r.role == RolePrimary || r.satisfies(needs)
+		wake := r.wake
+		r.mu.Unlock()
+		if ok {
+			return true
+		}
+		select {
+		case <-wake:
+		case <-timer.C:
+			return false
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// read serves a read, on a replica once it meets the position or
+// staleness asked by the request, or else on the primary.
+func (r *Replication) read(ctx context.Context, method string, req interface{}, handler grpc.UnaryHandler) (interface{}, error) {
+	needs, err := parseReadNeeds(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if needs == nil || r.await(ctx, needs) {
+		return handler(ctx, req)
+	}
+	return r.forward(ctx, method, req)
+}
+
+// forward sends a read to the primary.
+func (r *Replication) forward(ctx context.Context, method string, req interface{}) (interface{}, error) {
+	r.mu.Lock()
+	conn := r.conn
+	r.mu.Unlock()
+	if conn == nil {
+		return nil, status.Error(codes.Unavailable, ErrNotInSync.Error())
+	}
+	resp, err := cluster.NewResponse(method)
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.Invoke(ctx, method, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	pb "github.com/shanukun/cash/cash_proto"
+)
+
+var (
+	ErrSlowConsumer = errors.New("Subscriber disconnected, buffer full")
+)
+
+const (
+	defaultSubscriberBuffer = 128
+	maxSubscriberBuffer     = 65536
+)
+
+// subscriber is a single Subscribe stream. Messages are queued on a
+// bounded buffer; when it is full the message is dropped or the
+// subscriber is disconnected, depending on its policy.
+type subscriber struct {
+	channels map[string]bool
+	patterns []string
+	policy   pb.SlowConsumerPolicy
+	messages chan *pb.Message
+	kicked   chan struct{}
+	kickOnce sync.Once
+}
+
+func (s *subscriber) match(channel string) (string, bool) {
+	if s.channels[channel] {
+		return "", true
+	}
+	for _, p := range s.patterns {
+		if matchGlob(p, channel) {
+			return p, true
+		}
+	}
+	return "", false
+}
+
+func (s *subscriber) kick() {
+	s.kickOnce.Do(func() {
+		close(s.kicked)
+	})
+}
+
+type pubsub struct {
+	mu          sync.RWMutex
+	subscribers map[*subscriber]struct{}
+}
+
+func newPubSub() *pubsub {
+	return &pubsub{
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+func (ps *pubsub) subscribe(args *pb.SubscribeRequest) *subscriber {
+	buffer := int(args.Buffer)
+	if buffer <= 0 {
+		buffer = defaultSubscriberBuffer
+	} else if buffer > maxSubscriberBuffer {
+		buffer = maxSubscriberBuffer
+	}
+
+	s := &subscriber{
+		channels: make(map[string]bool),
+		patterns: args.Patterns,
+		policy:   args.Policy,
+		messages: make(chan *pb.Message, buffer),
+		kicked:   make(chan struct{}),
+	}
+	for _, ch := range args.Channels {
+		s.channels[ch] = true
+	}
+
+	ps.mu.Lock()
+	ps.subscribers[s] = struct{}{}
+	ps.mu.Unlock()
+	return s
+}
+
+func (ps *pubsub) unsubscribe(s *subscriber) {
+	ps.mu.Lock()
+	delete(ps.subscribers, s)
+	ps.mu.Unlock()
+}
+
+// publish delivers message to every matching subscriber without blocking
+// and returns the number of subscribers it was queued for.
+func (ps *pubsub) publish(channel, message string) int64 {
+	var receivers int64
+
+	ps.mu.RLock()
+	for s := range ps.subscribers {
+		pattern, ok := s.match(channel)
+		if !ok {
+			continue
+		}
+
+		msg := &pb.Message{
+			Channel: channel,
+			Message: message,
+			Pattern: pattern,
+		}
+		select {
+		case s.messages <- msg:
+			receivers++
+		default:
+			if s.policy == pb.SlowConsumerPolicy_DISCONNECT {
+				s.kick()
+			}
+		}
+	}
+	ps.mu.RUnlock()
+
+	return receivers
+}
+
+func (c *cache) Publish(ctx context.Context, msg *pb.Message) (*pb.PublishResult, error) {
+	return &pb.PublishResult{
+		Receivers: c.pubsub.publish(msg.Channel, msg.Message),
+	}, nil
+}
+
+func (c *cache) Subscribe(args *pb.SubscribeRequest, stream pb.CacheService_SubscribeServer) error {
+	s := c.pubsub.subscribe(args)
+	defer c.pubsub.unsubscribe(s)
+
+	ctx := stream.Context()
+	for {
+		select {
+		case msg := <-s.messages:
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		case <-s.kicked:
+			return ErrSlowConsumer
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
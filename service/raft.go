@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	"github.com/shanukun/cash/raft"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var errSnapshotBusy = errors.New("Snapshot postponed by a write in progress")
+
+// commandResult is the result of a replicated command, which is returned
+// to the client rather than failing the proposal.
+type commandResult struct {
+	resp interface{}
+	err  error
+}
+
+// readOnlyMethods are the methods of CacheService which do not change the
+// cache.
+var readOnlyMethods = map[string]bool{
+	"Get": true, "GetList": true, "GetHashMap": true, "SMembers": true,
+	"XLen": true, "XRange": true, "XRead": true, "XPending": true,
+	"PFCount": true, "BFExists": true, "BFInfo": true,
+	"CFExists": true, "CFInfo": true,
+	"CMSQuery": true, "CMSInfo": true,
+	"TopKQuery": true, "TopKList": true,
+	"GetBit": true, "BitCount": true, "BitPos": true,
+	"GeoPos": true, "GeoDist": true, "GeoSearch": true,
+	"JSONGet": true, "JSONType": true, "JSONLen": true,
+	"TSGet": true, "TSRange": true, "TSInfo": true, "TSQueryIndex": true,
+	"VGet": true, "VSearch": true, "VInfo": true,
+	"IndexInfo": true, "QueryIndex": true,
+	"FTInfo": true, "FTSearch": true,
+}
+
+// ReadOnly reports whether the method of CacheService called name does not
+// change the cache, so that it can be sent again after a failure.
+func ReadOnly(name string) bool {
+	return readOnlyMethods[name]
+}
+
+// commandMethods are the methods replicated as the request itself rather
+// than the keys they change.
+var commandMethods = map[string]bool{
+	"CreateIndex": true, "DropIndex": true,
+	"FTCreate": true, "FTDrop": true,
+}
+
+// Raft replicates the writes to a cache through a Raft group. Writes are
+// served by the leader only: it runs them, then proposes the resulting
+// changes and answers once they are committed. Followers apply committed
+// changes, and the log is compacted with snapshots of the cache.
+type Raft struct {
+	// mu lets reads run together but one write at a time, from its
+	// execution to its commit.
+	mu    sync.RWMutex
+	cache *cache
+	node  *raft.Node
+}
+
+// NewRaft returns the Raft group member replicating c, to be started with
+// Start.
+func NewRaft(c *Cache, cfg raft.Config) (*Raft, error) {
+	r := &Raft{cache: c.cache}
+	node, err := raft.New(cfg, r)
+	if err != nil {
+		return nil, err
+	}
+	r.node = node
+	return r, nil
+}
+
+func (r *Raft) Node() *raft.Node {
+	return r.node
+}
+
+func (r *Raft) Start() {
+	r.node.Start()
+}
+
+func (r *Raft) Stop() {
+	r.node.Stop()
+}
+
+// Apply applies committed changes. The leader already holds the changes
+// it proposed, but runs the commands it proposed.
+func (r *Raft) Apply(data []byte, local bool) (interface{}, error) {
+	changes := &pb.CacheChanges{}
+	if err := proto.Unmarshal(data, changes); err != nil {
+		return nil, err
+	}
+	if changes.Method != "" {
+		resp, err := r.cache.runCommand(changes.Method, changes.Request)
+		return commandResult{resp: resp, err: err}, nil
+	}
+	if local {
+		return nil, nil
+	}
+	return nil, r.cache.ApplyChanges(changes)
+}
+
+// Snapshot is postponed while a write is in progress, as the cache then
+// holds changes which are not committed yet.
+func (r *Raft) Snapshot() ([]byte, error) {
+	if !r.mu.TryRLock() {
+		return nil, errSnapshotBusy
+	}
+	defer r.mu.RUnlock()
+	return r.cache.SnapshotState()
+}
+
+func (r *Raft) Restore(data []byte) error {
+	return r.cache.RestoreState(data)
+}
+
+// isBlocking reports whether req asks to wait for a change, as with the
+// wait of AcquireLock or the block of XRead. Such requests would hold up
+// every other write. Like the handlers, a duration that does not parse
+// does not wait.
+func isBlocking(req interface{}) bool {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return false
+	}
+	msg := m.ProtoReflect()
+	for _, name := range []protoreflect.Name{"wait", "block"} {
+		fd := msg.Descriptor().Fields().ByName(name)
+		if fd == nil || fd.Kind() != protoreflect.StringKind {
+			continue
+		}
+		if d, _ := time.ParseDuration(msg.Get(fd).String()); d > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Raft) error(err error) error {
+	if errors.Is(err, raft.ErrNotLeader) {
+		return r.node.NotLeaderError()
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	return status.Errorf(codes.Unavailable, "TRYAGAIN %v", err)
+}
+
+func (r *Raft) handle(ctx context.Context, method string, req interface{}, handler grpc.UnaryHandler) (interface{}, error) {
+	name := strings.TrimPrefix(method, "/CacheService/")
+	switch {
+	case name == "Publish":
+		return handler(ctx, req)
+	case isBlocking(req):
+		return nil, status.Error(codes.InvalidArgument, "Blocking requests are not supported in Raft mode")
+	case !r.node.IsLeader():
+		return nil, r.node.NotLeaderError()
+	case readOnlyMethods[name]:
+		r.mu.RLock()
+		defer r.mu.RUnlock()
+		if err := r.node.ReadIndex(ctx); err != nil {
+			return nil, r.error(err)
+		}
+		return handler(ctx, req)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	// Reading the index also makes sure every committed change is applied
+	// before running the write on top of them.
+	if err := r.node.ReadIndex(ctx); err != nil {
+		return nil, r.error(err)
+	}
+
+	if commandMethods[name] {
+		request, err := proto.Marshal(req.(proto.Message))
+		if err != nil {
+			return nil, err
+		}
+		data, err := proto.Marshal(&pb.CacheChanges{Method: method, Request: request})
+		if err != nil {
+			return nil, err
+		}
+		res, err := r.node.Propose(ctx, data)
+		if err != nil {
+			return nil, r.error(err)
+		}
+		return res.(commandResult).resp, res.(commandResult).err
+	}
+
+	r.cache.TrackChanges()
+	resp, err := handler(ctx, req)
+	changes, cerr := r.cache.DrainChanges()
+	if cerr != nil {
+		r.node.Resync()
+		return nil, cerr
+	}
+	if changes == nil {
+		return resp, err
+	}
+	data, perr := proto.Marshal(changes)
+	if perr == nil {
+		_, perr = r.node.Propose(ctx, data)
+	}
+	if perr != nil {
+		// The changes may never be committed, so the cache is rebuilt from
+		// the log.
+		r.node.Resync()
+		return nil, r.error(perr)
+	}
+	return resp, err
+}
+
+// UnaryInterceptor serves the requests to CacheService through the Raft
+// group. Other nodes than the leader answer with NOTLEADER errors which
+// carry the address of the leader. Publish stays local.
+func (r *Raft) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, "/CacheService/") {
+			return handler(ctx, req)
+		}
+		return r.handle(ctx, info.FullMethod, req, handler)
+	}
+}
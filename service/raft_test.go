@@ -0,0 +1,470 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
+	"github.com/shanukun/cash/raft"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// call is a request to a method of CacheService.
+type call struct {
+	method string
+	req    proto.Message
+}
+
+func (c *Cache) invoke(ctx context.Context, cl call) error {
+	out := reflect.ValueOf(c).MethodByName(cl.method).Call([]reflect.Value{
+		reflect.ValueOf(ctx), reflect.ValueOf(cl.req),
+	})
+	err, _ := out[1].Interface().(error)
+	return err
+}
+
+// writes holds a change made by each method of CacheService which is
+// neither read only nor replicated as a command, after the calls setting
+// up the keys it changes.
+var writes = map[string]struct {
+	setup []call
+	req   proto.Message
+}{
+	"Set":       {req: &pb.String{Key: "k", Value: "v"}},
+	"SetCond":   {req: &pb.SetItem{Key: "k", Value: "v"}},
+	"GetSet":    {req: &pb.String{Key: "k", Value: "v"}},
+	"DeleteKey": {setup: []call{{"Set", &pb.String{Key: "k", Value: "v"}}}, req: &pb.Key{Key: "k"}},
+	"IncrBy":    {req: &pb.CounterRequest{Key: "k", Delta: 1}},
+	"LPush":     {req: &pb.String{Key: "k", Value: "v"}},
+	"RPush":     {req: &pb.String{Key: "k", Value: "v"}},
+	"HMSet":     {req: &pb.HashMapItem{Key: "k", Field: "f", Value: "v"}},
+	"SAdd":      {req: &pb.SetMembersRequest{Key: "k", Members: []string{"m"}}},
+	"SRem": {
+		setup: []call{{"SAdd", &pb.SetMembersRequest{Key: "k", Members: []string{"m", "n"}}}},
+		req:   &pb.SetMembersRequest{Key: "k", Members: []string{"m"}},
+	},
+	"DeleteAll":   {setup: []call{{"Set", &pb.String{Key: "k", Value: "v"}}}, req: &emptypb.Empty{}},
+	"AcquireLock": {req: &pb.LockRequest{Key: "k", Owner: "o", Ttl: "1m"}},
+	"RenewLock": {
+		setup: []call{{"AcquireLock", &pb.LockRequest{Key: "k", Owner: "o", Ttl: "1m"}}},
+		req:   &pb.LockRequest{Key: "k", Owner: "o", Ttl: "2m"},
+	},
+	"ReleaseLock": {
+		setup: []call{{"AcquireLock", &pb.LockRequest{Key: "k", Owner: "o", Ttl: "1m"}}},
+		req:   &pb.Lock{Key: "k", Owner: "o"},
+	},
+
+	"XAdd": {req: &pb.StreamAddRequest{Key: "k", Id: "*", Fields: map[string]string{"f": "v"}}},
+	"XTrim": {
+		setup: []call{
+			{"XAdd", &pb.StreamAddRequest{Key: "k", Id: "1-0", Fields: map[string]string{"f": "v"}}},
+			{"XAdd", &pb.StreamAddRequest{Key: "k", Id: "2-0", Fields: map[string]string{"f": "v"}}},
+		},
+		req: &pb.StreamTrimRequest{Key: "k", MaxLen: 1},
+	},
+	"XGroupCreate": {req: &pb.StreamGroupRequest{Key: "k", Group: "g", Id: "0", Mkstream: true}},
+	"XReadGroup": {
+		setup: []call{
+			{"XAdd", &pb.StreamAddRequest{Key: "k", Id: "1-0", Fields: map[string]string{"f": "v"}}},
+			{"XGroupCreate", &pb.StreamGroupRequest{Key: "k", Group: "g", Id: "0"}},
+		},
+		req: &pb.StreamReadGroupRequest{Key: "k", Group: "g", Consumer: "c", Id: ">"},
+	},
+	"XAck": {
+		setup: []call{
+			{"XAdd", &pb.StreamAddRequest{Key: "k", Id: "1-0", Fields: map[string]string{"f": "v"}}},
+			{"XGroupCreate", &pb.StreamGroupRequest{Key: "k", Group: "g", Id: "0"}},
+			{"XReadGroup", &pb.StreamReadGroupRequest{Key: "k", Group: "g", Consumer: "c", Id: ">"}},
+		},
+		req: &pb.StreamAckRequest{Key: "k", Group: "g", Ids: []string{"1-0"}},
+	},
+	"XClaim": {
+		setup: []call{
+			{"XAdd", &pb.StreamAddRequest{Key: "k", Id: "1-0", Fields: map[string]string{"f": "v"}}},
+			{"XGroupCreate", &pb.StreamGroupRequest{Key: "k", Group: "g", Id: "0"}},
+			{"XReadGroup", &pb.StreamReadGroupRequest{Key: "k", Group: "g", Consumer: "c", Id: ">"}},
+		},
+		req: &pb.StreamClaimRequest{Key: "k", Group: "g", Consumer: "d", Ids: []string{"1-0"}},
+	},
+
+	"PFAdd": {req: &pb.HLLAddRequest{Key: "k", Elements: []string{"e"}}},
+	"PFMerge": {
+		setup: []call{{"PFAdd", &pb.HLLAddRequest{Key: "s", Elements: []string{"e"}}}},
+		req:   &pb.HLLMergeRequest{Dest: "k", Sources: []string{"s"}},
+	},
+	"BFReserve": {req: &pb.BloomReserveRequest{Key: "k", Capacity: 100, ErrorRate: 0.01}},
+	"BFAdd":     {req: &pb.FilterItems{Key: "k", Items: []string{"i"}}},
+	"CFReserve": {req: &pb.CuckooReserveRequest{Key: "k", Capacity: 100}},
+	"CFAdd":     {req: &pb.FilterItems{Key: "k", Items: []string{"i"}}},
+	"CFDel": {
+		setup: []call{{"CFAdd", &pb.FilterItems{Key: "k", Items: []string{"i"}}}},
+		req:   &pb.FilterItems{Key: "k", Items: []string{"i"}},
+	},
+	"CMSInit": {req: &pb.CMSInitRequest{Key: "k", Width: 10, Depth: 2}},
+	"CMSIncrBy": {
+		setup: []call{{"CMSInit", &pb.CMSInitRequest{Key: "k", Width: 10, Depth: 2}}},
+		req:   &pb.IncrRequest{Key: "k", Items: []*pb.ItemIncrement{{Item: "i", Increment: 1}}},
+	},
+	"CMSMerge": {
+		setup: []call{
+			{"CMSInit", &pb.CMSInitRequest{Key: "k", Width: 10, Depth: 2}},
+			{"CMSInit", &pb.CMSInitRequest{Key: "s", Width: 10, Depth: 2}},
+			{"CMSIncrBy", &pb.IncrRequest{Key: "s", Items: []*pb.ItemIncrement{{Item: "i", Increment: 1}}}},
+		},
+		req: &pb.CMSMergeRequest{Dest: "k", Sources: []string{"s"}},
+	},
+	"TopKReserve": {req: &pb.TopKReserveRequest{Key: "k", K: 3}},
+	"TopKIncrBy": {
+		setup: []call{{"TopKReserve", &pb.TopKReserveRequest{Key: "k", K: 3}}},
+		req:   &pb.IncrRequest{Key: "k", Items: []*pb.ItemIncrement{{Item: "i", Increment: 1}}},
+	},
+
+	"SetBit": {req: &pb.BitRequest{Key: "k", Offset: 3, Value: 1}},
+	"BitOp": {
+		setup: []call{{"SetBit", &pb.BitRequest{Key: "s", Offset: 3, Value: 1}}},
+		req:   &pb.BitOpRequest{Op: pb.BitOperation_OR, Dest: "k", Keys: []string{"s"}},
+	},
+	"BitField": {
+		req: &pb.BitFieldRequest{Key: "k", Ops: []*pb.BitFieldOp{{Type: pb.BitFieldOpType_BITFIELD_SET, Encoding: "u8", Value: 7}}},
+	},
+	"GeoAdd": {req: &pb.GeoAddRequest{Key: "k", Members: []*pb.GeoMember{{Member: "m", Longitude: 13.4, Latitude: 52.5}}}},
+	"GeoRem": {
+		setup: []call{{"GeoAdd", &pb.GeoAddRequest{Key: "k", Members: []*pb.GeoMember{
+			{Member: "m", Longitude: 13.4, Latitude: 52.5},
+			{Member: "n", Longitude: 2.35, Latitude: 48.86},
+		}}}},
+		req: &pb.GeoMembersRequest{Key: "k", Members: []string{"m"}},
+	},
+
+	"JSONSet": {req: &pb.JSONSetRequest{Key: "k", Path: "$", Value: `{"n":1,"a":[1]}`}},
+	"JSONDel": {
+		setup: []call{{"JSONSet", &pb.JSONSetRequest{Key: "k", Path: "$", Value: `{"n":1,"a":[1]}`}}},
+		req:   &pb.JSONPathRequest{Key: "k", Path: "$.n"},
+	},
+	"JSONNumIncrBy": {
+		setup: []call{{"JSONSet", &pb.JSONSetRequest{Key: "k", Path: "$", Value: `{"n":1,"a":[1]}`}}},
+		req:   &pb.JSONNumRequest{Key: "k", Path: "$.n", Value: "2"},
+	},
+	"JSONArrAppend": {
+		setup: []call{{"JSONSet", &pb.JSONSetRequest{Key: "k", Path: "$", Value: `{"n":1,"a":[1]}`}}},
+		req:   &pb.JSONArrRequest{Key: "k", Path: "$.a", Values: []string{"2"}},
+	},
+	"JSONArrInsert": {
+		setup: []call{{"JSONSet", &pb.JSONSetRequest{Key: "k", Path: "$", Value: `{"n":1,"a":[1]}`}}},
+		req:   &pb.JSONArrRequest{Key: "k", Path: "$.a", Values: []string{"2"}},
+	},
+	"JSONArrPop": {
+		setup: []call{{"JSONSet", &pb.JSONSetRequest{Key: "k", Path: "$", Value: `{"n":1,"a":[1]}`}}},
+		req:   &pb.JSONArrRequest{Key: "k", Path: "$.a", Index: -1},
+	},
+
+	"TSCreate": {req: &pb.TSCreateRequest{Key: "k"}},
+	"TSAdd":    {req: &pb.TSAddRequest{Key: "k", Timestamp: 1, Value: 1}},
+	"TSCreateRule": {
+		setup: []call{
+			{"TSCreate", &pb.TSCreateRequest{Key: "k"}},
+			{"TSCreate", &pb.TSCreateRequest{Key: "d"}},
+		},
+		req: &pb.TSRuleRequest{Source: "k", Dest: "d", Aggregation: pb.Aggregation_AGG_SUM, Bucket: "1s"},
+	},
+	"TSDeleteRule": {
+		setup: []call{
+			{"TSCreate", &pb.TSCreateRequest{Key: "k"}},
+			{"TSCreate", &pb.TSCreateRequest{Key: "d"}},
+			{"TSCreateRule", &pb.TSRuleRequest{Source: "k", Dest: "d", Aggregation: pb.Aggregation_AGG_SUM, Bucket: "1s"}},
+		},
+		req: &pb.TSRuleRequest{Source: "k", Dest: "d"},
+	},
+
+	"VCreate": {req: &pb.VectorCreateRequest{Key: "k", Dim: 2}},
+	"VAdd": {
+		setup: []call{{"VCreate", &pb.VectorCreateRequest{Key: "k", Dim: 2}}},
+		req:   &pb.VectorAddRequest{Key: "k", Vectors: []*pb.Vector{{Id: "v", Values: []float32{1, 0}}}},
+	},
+	"VDel": {
+		setup: []call{
+			{"VCreate", &pb.VectorCreateRequest{Key: "k", Dim: 2}},
+			{"VAdd", &pb.VectorAddRequest{Key: "k", Vectors: []*pb.Vector{{Id: "v", Values: []float32{1, 0}}}}},
+		},
+		req: &pb.VectorIDs{Key: "k", Ids: []string{"v"}},
+	},
+}
+
+// TestWritesRecordChanges checks that every write records the keys it
+// changes, which are all Raft and replication ship to other nodes.
+func TestWritesRecordChanges(t *testing.T) {
+	ctx := context.Background()
+	service := pb.File_cash_proto_cash_proto.Services().ByName("CacheService")
+	methods := service.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		m := methods.Get(i)
+		name := string(m.Name())
+		if m.IsStreamingServer() || readOnlyMethods[name] || commandMethods[name] || name == "Publish" {
+			continue
+		}
+		w, ok := writes[name]
+		if !ok {
+			t.Errorf("%s is neither read only nor tested to record its changes", name)
+			continue
+		}
+
+		c := NewCacheService(time.Minute, time.Minute)
+		for _, s := range w.setup {
+			if err := c.invoke(ctx, s); err != nil {
+				t.Fatalf("%s: setup %s: %v", name, s.method, err)
+			}
+		}
+		before, err := c.SnapshotState()
+		if err != nil {
+			t.Fatal(err)
+		}
+		c.TrackChanges()
+		if err := c.invoke(ctx, call{name, w.req}); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		changes, err := c.DrainChanges()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if changes == nil {
+			t.Errorf("%s recorded no change", name)
+			continue
+		}
+
+		// The changes bring a copy of the cache from before the write to
+		// the same state.
+		r := NewCacheService(time.Minute, time.Minute)
+		if err := r.RestoreState(before); err != nil {
+			t.Fatal(err)
+		}
+		if err := r.ApplyChanges(changes); err != nil {
+			t.Fatal(err)
+		}
+		if !sameContents(t, c, r) {
+			t.Errorf("%s: changes do not reproduce the write", name)
+		}
+	}
+}
+
+// contents returns a copy of the values in c, and their expirations
+// apart, as copies restore them relative to the time they are made.
+func contents(c *Cache) (map[string]dt.AnyT, map[string]int64, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	values := make(map[string]dt.AnyT)
+	expirations := make(map[string]int64)
+	var err error
+	c.store.Ascend("", func(key string, v interface{}) bool {
+		var data []byte
+		if data, err = dt.Encode(v); err != nil {
+			return false
+		}
+		var value dt.AnyT
+		if value, err = dt.Decode(data); err != nil {
+			return false
+		}
+		expirations[key] = dt.Expiration(value)
+		dt.SetExpiration(value, 0)
+		values[key] = value
+		return true
+	})
+	return values, expirations, err
+}
+
+// sameContents reports whether a and b hold the same keys and values,
+// expiring within a second of each other.
+func sameContents(t *testing.T, a, b *Cache) bool {
+	t.Helper()
+	want, wantExp, err := contents(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, gotExp, err := contents(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(want, got) {
+		return false
+	}
+	for key, exp := range wantExp {
+		d := time.Duration(exp - gotExp[key])
+		if d < -time.Second || d > time.Second {
+			return false
+		}
+	}
+	return true
+}
+
+// raftMember is a node of a Raft group along with its cache.
+type raftMember struct {
+	address string
+	cache   *Cache
+	raft    *Raft
+}
+
+// newRaftGroup starts a Raft group of n caches over a LocalTransport.
+func newRaftGroup(t *testing.T, n int) ([]*raftMember, *raft.LocalTransport) {
+	t.Helper()
+	transport := raft.NewLocalTransport()
+	var members []*pb.RaftMember
+	for i := 0; i < n; i++ {
+		id := fmt.Sprint("node", i)
+		members = append(members, &pb.RaftMember{Id: id, Address: id})
+	}
+	var group []*raftMember
+	for _, m := range members {
+		c := NewCacheService(time.Minute, time.Minute)
+		r, err := NewRaft(c, raft.Config{
+			ID:                m.Id,
+			Address:           m.Address,
+			Members:           members,
+			ElectionTimeout:   150 * time.Millisecond,
+			HeartbeatInterval: 20 * time.Millisecond,
+			Transport:         transport.From(m.Address),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		transport.Register(m.Address, r.Node())
+		group = append(group, &raftMember{address: m.Address, cache: c, raft: r})
+	}
+	for _, m := range group {
+		m.raft.Start()
+		t.Cleanup(m.raft.Stop)
+	}
+	return group, transport
+}
+
+// leader waits for one of members to lead the group.
+func leader(t *testing.T, members []*raftMember) *raftMember {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		for _, m := range members {
+			if m.raft.Node().IsLeader() {
+				return m
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("No leader elected")
+	return nil
+}
+
+// write runs calls through the Raft group, as the gRPC interceptor does,
+// and waits for members to hold the result of each.
+func (m *raftMember) write(t *testing.T, members []*raftMember, calls ...call) {
+	t.Helper()
+	for _, cl := range calls {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		_, err := m.raft.handle(ctx, "/CacheService/"+cl.method, cl.req, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, m.cache.invoke(ctx, call{cl.method, req.(proto.Message)})
+		})
+		cancel()
+		if err != nil {
+			t.Fatalf("%s: %v", cl.method, err)
+		}
+		m.converge(t, cl.method, members)
+	}
+}
+
+// converge waits for every member to hold the contents of m.
+func (m *raftMember) converge(t *testing.T, after string, members []*raftMember) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for _, other := range members {
+		for !sameContents(t, m.cache, other.cache) {
+			if time.Now().After(deadline) {
+				t.Fatalf("%s does not hold the contents of the leader %s after %s", other.address, m.address, after)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+}
+
+func TestRaftFailover(t *testing.T) {
+	group, transport := newRaftGroup(t, 3)
+	first := leader(t, group)
+	first.write(t, group,
+		call{"XAdd", &pb.StreamAddRequest{Key: "stream", Id: "1-0", Fields: map[string]string{"f": "v"}}},
+		call{"XAdd", &pb.StreamAddRequest{Key: "stream", Id: "2-0", Fields: map[string]string{"f": "v"}}},
+		call{"XAdd", &pb.StreamAddRequest{Key: "stream", Id: "3-0", Fields: map[string]string{"f": "v"}}},
+		call{"XGroupCreate", &pb.StreamGroupRequest{Key: "stream", Group: "g", Id: "0"}},
+		call{"XReadGroup", &pb.StreamReadGroupRequest{Key: "stream", Group: "g", Consumer: "c", Id: ">"}},
+		call{"XAck", &pb.StreamAckRequest{Key: "stream", Group: "g", Ids: []string{"1-0"}}},
+		call{"XClaim", &pb.StreamClaimRequest{Key: "stream", Group: "g", Consumer: "d", Ids: []string{"2-0"}}},
+		call{"XTrim", &pb.StreamTrimRequest{Key: "stream", MaxLen: 2}},
+		call{"AcquireLock", &pb.LockRequest{Key: "lock", Owner: "o", Ttl: "1m"}},
+		call{"RenewLock", &pb.LockRequest{Key: "lock", Owner: "o", Ttl: "1h"}},
+		call{"TSCreate", &pb.TSCreateRequest{Key: "ts"}},
+		call{"TSCreate", &pb.TSCreateRequest{Key: "ts:sum"}},
+		call{"TSAdd", &pb.TSAddRequest{Key: "ts", Timestamp: 1000, Value: 1}},
+		call{"TSCreateRule", &pb.TSRuleRequest{Source: "ts", Dest: "ts:sum", Aggregation: pb.Aggregation_AGG_SUM, Bucket: "1s"}},
+	)
+
+	// The followers elect a leader of their own, which goes on from the
+	// state they replicated.
+	transport.SetDown(first.address, true)
+	var rest []*raftMember
+	for _, m := range group {
+		if m != first {
+			rest = append(rest, m)
+		}
+	}
+	second := leader(t, rest)
+	second.write(t, rest,
+		call{"XAck", &pb.StreamAckRequest{Key: "stream", Group: "g", Ids: []string{"2-0"}}},
+		call{"XAdd", &pb.StreamAddRequest{Key: "stream", Id: "4-0", Fields: map[string]string{"f": "v"}}},
+		call{"ReleaseLock", &pb.Lock{Key: "lock", Owner: "o"}},
+		call{"TSAdd", &pb.TSAddRequest{Key: "ts", Timestamp: 2000, Value: 2}},
+		call{"TSDeleteRule", &pb.TSRuleRequest{Source: "ts", Dest: "ts:sum"}},
+	)
+
+	// The former leader catches up once reconnected.
+	transport.SetDown(first.address, false)
+	second = leader(t, group)
+	second.converge(t, "reconnecting", group)
+
+	ctx := context.Background()
+	pending, err := first.cache.XPending(ctx, &pb.StreamPendingRequest{Key: "stream", Group: "g"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending.Entries) != 1 || pending.Entries[0].Id != "3-0" {
+		t.Errorf("pending entries %v, want 3-0 only", pending.Entries)
+	}
+	info, err := first.cache.TSInfo(ctx, &pb.Key{Key: "ts"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(info.Rules) != 0 || info.Samples != 2 {
+		t.Errorf("time series has %d rules and %d samples, want 0 and 2", len(info.Rules), info.Samples)
+	}
+	if first.cache.Exists("lock") {
+		t.Error("released lock is still held")
+	}
+}
+
+func TestIsBlocking(t *testing.T) {
+	for _, test := range []struct {
+		req  interface{}
+		want bool
+	}{
+		{&pb.LockRequest{Key: "k"}, false},
+		{&pb.LockRequest{Key: "k", Wait: "0s"}, false},
+		{&pb.LockRequest{Key: "k", Wait: "1s"}, true},
+		{&pb.StreamReadRequest{Key: "k", Block: "0ms"}, false},
+		{&pb.StreamReadRequest{Key: "k", Block: "soon"}, false},
+		{&pb.StreamReadRequest{Key: "k", Block: "10ms"}, true},
+		{&pb.String{Key: "k"}, false},
+	} {
+		if got := isBlocking(test.req); got != test.want {
+			t.Errorf("isBlocking(%v) = %v, want %v", test.req, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,475 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/shanukun/cash/cash_proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Roles of a node in primary/replica replication.
+const (
+	RolePrimary = "primary"
+	RoleReplica = "replica"
+)
+
+const (
+	// defaultBacklog is the number of batches of changes kept for replicas
+	// to catch up without a snapshot.
+	defaultBacklog = 4096
+	// shipInterval is how often changes made outside of requests, such as
+	// expirations, are sent to replicas.
+	shipInterval = 100 * time.Millisecond
+	// heartbeatInterval is how often a primary without changes tells its
+	// replicas that they are in sync.
+	heartbeatInterval = 100 * time.Millisecond
+	replicaRetry      = time.Second
+)
+
+var (
+	ErrNotPrimary      = errors.New("Node is not a primary")
+	ErrSelfReplica     = errors.New("Node can not replicate itself")
+	ErrStaleEpoch      = errors.New("Replication configuration is older than the current one")
+	errHistoryChanged  = errors.New("Replication history changed")
+	errBacklogExceeded = errors.New("Replica fell behind the backlog")
+)
+
+func newReplid() string {
+	b := make([]byte, 20)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Replication replicates the writes to a cache from a primary to its
+// replicas, asynchronously. The primary records the keys changed by writes
+// and appends their values to a backlog, numbered by offset, which replicas
+// stream from. A replica which fell behind the backlog, or which followed
+// another history as identified by the replid, first receives a snapshot
+// of the cache. Replicas serve reads and reject writes.
+//
+// The epoch is set by whoever reconfigures the node, such as sentinels, so
+// that older configurations are refused.
+type Replication struct {
+	// switching serializes changes of role.
+	switching sync.Mutex
+	mu        sync.Mutex
+	cache     *cache
+	address   string
+	role      string
+	replid    string
+	offset    int64
+	epoch     int64
+	primary   string
+	linkUp    bool
+	// syncedAt is when a replica last had every change of its primary.
+	syncedAt time.Time
+	// conn is the connection of a replica to its primary, for the reads
+	// it forwards.
+	conn     *grpc.ClientConn
+	backlog  []*pb.ReplicationEvent
+	replicas map[string]*pb.ReplicaInfo
+	// wake is closed when the backlog grows or the role changes.
+	wake chan struct{}
+	stop context.CancelFunc
+	done chan struct{}
+	pb.UnimplementedReplicationServiceServer
+}
+
+// NewReplication returns the replication of c by the node reachable at
+// address, which starts as a primary.
+func NewReplication(c *Cache, address string) *Replication {
+	r := &Replication{
+		cache:    c.cache,
+		address:  address,
+		role:     RolePrimary,
+		replid:   newReplid(),
+		replicas: make(map[string]*pb.ReplicaInfo),
+		wake:     make(chan struct{}),
+	}
+	r.cache.TrackChanges()
+	return r
+}
+
+// Start sends the changes made outside of requests to replicas in the
+// background.
+func (r *Replication) Start() {
+	go func() {
+		for range time.Tick(shipInterval) {
+			r.mu.Lock()
+			r.ship()
+			r.mu.Unlock()
+		}
+	}()
+}
+
+func (r *Replication) wakeStreams() {
+	close(r.wake)
+	r.wake = make(chan struct{})
+}
+
+// ship appends the changes recorded since the last call to the backlog.
+// Must be called with r.mu held.
+func (r *Replication) ship() {
+	if r.role != RolePrimary {
+		return
+	}
+	changes, err := r.cache.drainChanges(true)
+	if err != nil {
+		// The changes are lost, so replicas start over from a snapshot.
+		r.newHistory()
+		return
+	}
+	if changes != nil {
+		r.append(changes)
+	}
+}
+
+func (r *Replication) append(changes *pb.CacheChanges) {
+	r.offset++
+	r.backlog = append(r.backlog, &pb.ReplicationEvent{
+		Replid:  r.replid,
+		Offset:  r.offset,
+		Changes: changes,
+	})
+	if len(r.backlog) > defaultBacklog {
+		r.backlog = r.backlog[1:]
+	}
+	r.wakeStreams()
+}
+
+func (r *Replication) newHistory() {
+	r.replid = newReplid()
+	r.backlog = nil
+	r.wakeStreams()
+}
+
+// shipCommand appends a request to the backlog, for replicas to run it.
+func (r *Replication) shipCommand(method string, req interface{}) {
+	request, err := proto.Marshal(req.(proto.Message))
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.role != RolePrimary {
+		return
+	}
+	r.ship()
+	if err != nil {
+		r.newHistory()
+		return
+	}
+	r.append(&pb.CacheChanges{Method: method, Request: request})
+}
+
+// Replicate streams the changes of the primary to a replica, starting with
+// a snapshot unless the replica can catch up from the backlog.
+func (r *Replication) Replicate(req *pb.ReplicaSync, stream pb.ReplicationService_ReplicateServer) error {
+	r.mu.Lock()
+	if r.role != RolePrimary {
+		r.mu.Unlock()
+		return ErrNotPrimary
+	}
+	r.ship()
+	replid, next := r.replid, req.Offset
+	// The first event tells the replica whether it is in sync, even if
+	// there is nothing to catch up. Otherwise the last event of the backlog
+	// does.
+	first := &pb.ReplicationEvent{Replid: r.replid, Offset: next, CaughtUp: next == r.offset}
+	if req.Replid != r.replid || req.Offset > r.offset || req.Offset < r.offset-int64(len(r.backlog)) {
+		data, err := r.cache.SnapshotState()
+		if err != nil {
+			r.mu.Unlock()
+			return err
+		}
+		next = r.offset
+		first = &pb.ReplicationEvent{
+			Replid:   r.replid,
+			Offset:   r.offset,
+			FullSync: true,
+			Snapshot: data,
+			CaughtUp: true,
+		}
+	}
+	info := &pb.ReplicaInfo{Address: req.Address, Offset: next}
+	r.replicas[req.Address] = info
+	r.mu.Unlock()
+
+	defer func() {
+		r.mu.Lock()
+		if r.replicas[req.Address] == info {
+			delete(r.replicas, req.Address)
+		}
+		r.mu.Unlock()
+	}()
+
+	if err := stream.Send(first); err != nil {
+		return err
+	}
+	ctx := stream.Context()
+	for {
+		r.mu.Lock()
+		if r.role != RolePrimary || r.replid != replid {
+			r.mu.Unlock()
+			return errHistoryChanged
+		}
+		if next < r.offset-int64(len(r.backlog)) {
+			r.mu.Unlock()
+			return errBacklogExceeded
+		}
+		events := r.backlog[len(r.backlog)-int(r.offset-next):]
+		wake := r.wake
+		r.mu.Unlock()
+
+		if len(events) == 0 {
+			select {
+			case <-wake:
+			case <-time.After(heartbeatInterval):
+				if err := stream.Send(&pb.ReplicationEvent{Replid: replid, Offset: next, CaughtUp: true}); err != nil {
+					return err
+				}
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			continue
+		}
+		for i, ev := range events {
+			// The last event is the newest change of the primary. Events are
+			// shared by the streams, so it is copied.
+			if i == len(events)-1 {
+				ev = &pb.ReplicationEvent{Replid: ev.Replid, Offset: ev.Offset, Changes: ev.Changes, CaughtUp: true}
+			}
+			if err := stream.Send(ev); err != nil {
+				return err
+			}
+		}
+		next = events[len(events)-1].Offset
+		r.mu.Lock()
+		info.Offset = next
+		r.mu.Unlock()
+	}
+}
+
+// follow replicates the primary at address until ctx is done,
+// reconnecting after failures.
+func (r *Replication) follow(ctx context.Context, address string, done chan struct{}) {
+	defer close(done)
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	r.mu.Lock()
+	r.conn = conn
+	r.mu.Unlock()
+	defer func() {
+		r.mu.Lock()
+		if r.conn == conn {
+			r.conn = nil
+		}
+		r.mu.Unlock()
+	}()
+	client := pb.NewReplicationServiceClient(conn)
+	for {
+		r.replicate(ctx, client)
+		r.mu.Lock()
+		r.linkUp = false
+		r.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(replicaRetry):
+		}
+	}
+}
+
+func (r *Replication) replicate(ctx context.Context, client pb.ReplicationServiceClient) error {
+	r.mu.Lock()
+	req := &pb.ReplicaSync{
+		Replid:  r.replid,
+		Offset:  r.offset,
+		Address: r.address,
+	}
+	r.mu.Unlock()
+	stream, err := client.Replicate(ctx, req)
+	if err != nil {
+		return err
+	}
+	for {
+		ev, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if err := r.apply(ev); err != nil {
+			return err
+		}
+		r.mu.Lock()
+		r.replid, r.offset, r.linkUp = ev.Replid, ev.Offset, true
+		if ev.CaughtUp {
+			r.syncedAt = time.Now()
+		}
+		r.wakeStreams()
+		r.mu.Unlock()
+	}
+}
+
+func (r *Replication) apply(ev *pb.ReplicationEvent) error {
+	switch {
+	case ev.FullSync:
+		return r.cache.RestoreState(ev.Snapshot)
+	case ev.Changes == nil:
+		return nil
+	case ev.Changes.Method != "":
+		// The command succeeded on the primary, and fails here the same way
+		// if it fails, as when an index already exists.
+		r.cache.runCommand(ev.Changes.Method, ev.Changes.Request)
+		return nil
+	}
+	return r.cache.ApplyChanges(ev.Changes)
+}
+
+// ReplicaOf makes the node a replica of the primary at address, or a
+// primary if address is empty. A promoted replica keeps its keys but
+// starts a new history, so that its replicas resync from a snapshot.
+func (r *Replication) ReplicaOf(ctx context.Context, req *pb.ReplicaOfRequest) (*pb.ReplicationState, error) {
+	if req.Address == r.address {
+		return nil, ErrSelfReplica
+	}
+	r.switching.Lock()
+	defer r.switching.Unlock()
+
+	r.mu.Lock()
+	if req.Epoch < r.epoch {
+		r.mu.Unlock()
+		return nil, ErrStaleEpoch
+	}
+	r.epoch = req.Epoch
+	if (req.Address == "" && r.role == RolePrimary) || (req.Address != "" && req.Address == r.primary) {
+		defer r.mu.Unlock()
+		return r.state(), nil
+	}
+	stop, done := r.stop, r.done
+	r.stop, r.done = nil, nil
+	r.mu.Unlock()
+	if stop != nil {
+		stop()
+		<-done
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.linkUp = false
+	if req.Address == "" {
+		r.role, r.primary = RolePrimary, ""
+		r.cache.TrackChanges()
+		r.newHistory()
+		return r.state(), nil
+	}
+
+	if r.role == RolePrimary {
+		r.cache.DrainChanges()
+	}
+	r.role, r.primary = RoleReplica, req.Address
+	r.wakeStreams()
+	ctx, r.stop = context.WithCancel(context.Background())
+	r.done = make(chan struct{})
+	go r.follow(ctx, req.Address, r.done)
+	return r.state(), nil
+}
+
+func (r *Replication) state() *pb.ReplicationState {
+	st := &pb.ReplicationState{
+		Role:    r.role,
+		Address: r.address,
+		Replid:  r.replid,
+		Offset:  r.offset,
+		Epoch:   r.epoch,
+		Primary: r.primary,
+		LinkUp:  r.linkUp,
+	}
+	for _, info := range r.replicas {
+		st.Replicas = append(st.Replicas, &pb.ReplicaInfo{
+			Address: info.Address,
+			Offset:  info.Offset,
+		})
+	}
+	sort.Slice(st.Replicas, func(i, j int) bool {
+		return st.Replicas[i].Address < st.Replicas[j].Address
+	})
+	return st
+}
+
+func (r *Replication) State() *pb.ReplicationState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.state()
+}
+
+func (r *Replication) ReplicationInfo(ctx context.Context, _ *empty.Empty) (*pb.ReplicationState, error) {
+	return r.State(), nil
+}
+
+// readOnlyError returns the error telling clients to send writes to the
+// primary. Besides its message, the error carries a pb.Redirect detail
+// with the primary.
+func readOnlyError(primary string) error {
+	st := status.New(codes.FailedPrecondition, fmt.Sprintf("READONLY %s", primary))
+	st, err := st.WithDetails(&pb.Redirect{Address: primary})
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	return st.Err()
+}
+
+// UnaryInterceptor sends the changes of each write to CacheService to the
+// replicas once it is done, and returns the resulting position in the
+// header of the response. Replicas serve reads and Publish, and answer
+// writes with READONLY errors which carry the address of the primary.
+// Reads which need a newer position or fresher data than a replica has
+// wait for it, or are forwarded to the primary.
+func (r *Replication) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, "/CacheService/") {
+			return handler(ctx, req)
+		}
+		name := strings.TrimPrefix(info.FullMethod, "/CacheService/")
+		if readOnlyMethods[name] {
+			return r.read(ctx, info.FullMethod, req, handler)
+		}
+		if name == "Publish" {
+			return handler(ctx, req)
+		}
+		r.mu.Lock()
+		role, primary := r.role, r.primary
+		r.mu.Unlock()
+		if role != RolePrimary {
+			return nil, readOnlyError(primary)
+		}
+
+		resp, err := handler(ctx, req)
+		if commandMethods[name] && err == nil {
+			r.shipCommand(info.FullMethod, req)
+		}
+		r.mu.Lock()
+		if !commandMethods[name] {
+			r.ship()
+		}
+		position := r.position()
+		r.mu.Unlock()
+		if err == nil {
+			grpc.SetHeader(ctx, metadata.Pairs(positionKey, position))
+		}
+		return resp, err
+	}
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	"github.com/shanukun/cash/cluster"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// replicationNode is a cache served over gRPC with replication.
+type replicationNode struct {
+	address     string
+	cache       *Cache
+	replication *Replication
+	conn        *grpc.ClientConn
+}
+
+func newReplicationNode(t *testing.T) *replicationNode {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := &replicationNode{address: lis.Addr().String(), cache: NewCacheService(time.Minute, time.Minute)}
+	n.replication = NewReplication(n.cache, n.address)
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(n.replication.UnaryInterceptor()))
+	pb.RegisterCacheServiceServer(s, n.cache)
+	pb.RegisterReplicationServiceServer(s, n.replication)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+
+	n.conn, err = grpc.Dial(n.address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { n.conn.Close() })
+	return n
+}
+
+func TestReplicationShipsWrites(t *testing.T) {
+	ctx := context.Background()
+	primary, replica := newReplicationNode(t), newReplicationNode(t)
+	if _, err := replica.replication.ReplicaOf(ctx, &pb.ReplicaOfRequest{Address: primary.address}); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		replica.replication.ReplicaOf(ctx, &pb.ReplicaOfRequest{})
+	})
+
+	// The replica is checked after each write, as a later write to the
+	// same key would ship the changes of an earlier one.
+	calls := []call{
+		{"XAdd", &pb.StreamAddRequest{Key: "stream", Id: "1-0", Fields: map[string]string{"f": "v"}}},
+		{"XAdd", &pb.StreamAddRequest{Key: "stream", Id: "2-0", Fields: map[string]string{"f": "v"}}},
+		{"XAdd", &pb.StreamAddRequest{Key: "stream", Id: "3-0", Fields: map[string]string{"f": "v"}}},
+		{"XGroupCreate", &pb.StreamGroupRequest{Key: "stream", Group: "g", Id: "0"}},
+		{"XReadGroup", &pb.StreamReadGroupRequest{Key: "stream", Group: "g", Consumer: "c", Id: ">"}},
+		{"XAck", &pb.StreamAckRequest{Key: "stream", Group: "g", Ids: []string{"1-0"}}},
+		{"XClaim", &pb.StreamClaimRequest{Key: "stream", Group: "g", Consumer: "d", Ids: []string{"2-0"}}},
+		{"XTrim", &pb.StreamTrimRequest{Key: "stream", MaxLen: 2}},
+		{"AcquireLock", &pb.LockRequest{Key: "lock", Owner: "o", Ttl: "1m"}},
+		{"RenewLock", &pb.LockRequest{Key: "lock", Owner: "o", Ttl: "1h"}},
+		{"TSCreate", &pb.TSCreateRequest{Key: "ts"}},
+		{"TSCreate", &pb.TSCreateRequest{Key: "ts:sum"}},
+		{"TSCreateRule", &pb.TSRuleRequest{Source: "ts", Dest: "ts:sum", Aggregation: pb.Aggregation_AGG_SUM, Bucket: "1s"}},
+		{"TSDeleteRule", &pb.TSRuleRequest{Source: "ts", Dest: "ts:sum"}},
+	}
+	for _, cl := range calls {
+		method := "/CacheService/" + cl.method
+		resp, err := cluster.NewResponse(method)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := primary.conn.Invoke(ctx, method, cl.req, resp); err != nil {
+			t.Fatalf("%s: %v", cl.method, err)
+		}
+
+		deadline := time.Now().Add(5 * time.Second)
+		for !sameContents(t, primary.cache, replica.cache) {
+			if time.Now().After(deadline) {
+				t.Fatalf("Replica does not hold the contents of the primary after %s", cl.method)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+}
+
+// recordStream is the stream of a replica, recording the events sent
+// until the replica has count of them.
+type recordStream struct {
+	pb.ReplicationService_ReplicateServer
+	ctx    context.Context
+	cancel context.CancelFunc
+	count  int
+	events []*pb.ReplicationEvent
+}
+
+func (s *recordStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *recordStream) Send(ev *pb.ReplicationEvent) error {
+	s.events = append(s.events, ev)
+	if len(s.events) == s.count {
+		s.cancel()
+	}
+	return nil
+}
+
+func TestReplicationCaughtUp(t *testing.T) {
+	ctx := context.Background()
+	c := NewCacheService(time.Minute, time.Minute)
+	r := NewReplication(c, "primary")
+	for _, value := range []string{"1", "2", "3"} {
+		if _, err := c.Set(ctx, &pb.String{Key: "k", Value: value}); err != nil {
+			t.Fatal(err)
+		}
+		r.mu.Lock()
+		r.ship()
+		r.mu.Unlock()
+	}
+	state := r.State()
+
+	for _, test := range []struct {
+		name   string
+		offset int64
+		want   []bool
+	}{
+		{"partial resync", state.Offset - 2, []bool{false, false, true}},
+		{"in sync", state.Offset, []bool{true}},
+	} {
+		streamCtx, cancel := context.WithCancel(ctx)
+		stream := &recordStream{ctx: streamCtx, cancel: cancel, count: len(test.want)}
+		r.Replicate(&pb.ReplicaSync{Replid: state.Replid, Offset: test.offset, Address: "replica"}, stream)
+		cancel()
+		if len(stream.events) != len(test.want) {
+			t.Fatalf("%s: %d events sent, want %d", test.name, len(stream.events), len(test.want))
+		}
+		for i, ev := range stream.events {
+			if ev.CaughtUp != test.want[i] {
+				t.Errorf("%s: event %d at offset %d caught up %v, want %v", test.name, i, ev.Offset, ev.CaughtUp, test.want[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
+	ds "github.com/shanukun/cash/ds"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// changeSet collects the keys changed since it was last drained, for
+// their values to be sent to replicas.
+type changeSet struct {
+	keys    map[string]bool
+	flushed bool
+	fence   int64
+}
+
+func newChangeSet() *changeSet {
+	return &changeSet{keys: make(map[string]bool)}
+}
+
+func (s *changeSet) add(typ pb.EventType, key string) {
+	if typ == pb.EventType_FLUSH {
+		s.keys = make(map[string]bool)
+		s.flushed = true
+		return
+	}
+	s.keys[key] = true
+}
+
+// TrackChanges starts recording the keys changed by writes, until
+// DrainChanges returns them.
+func (c *cache) TrackChanges() {
+	c.mu.Lock()
+	c.changes = newChangeSet()
+	c.changes.fence = c.fence
+	c.mu.Unlock()
+}
+
+// DrainChanges returns the current value of the keys changed since
+// TrackChanges, or nil if nothing changed, and stops recording changes.
+// Keys which no longer exist are listed as deleted.
+func (c *cache) DrainChanges() (*pb.CacheChanges, error) {
+	return c.drainChanges(false)
+}
+
+// drainChanges is DrainChanges, going on recording changes from scratch
+// if track is set.
+func (c *cache) drainChanges(track bool) (*pb.CacheChanges, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s := c.changes
+	c.changes = nil
+	if track {
+		c.changes = newChangeSet()
+		c.changes.fence = c.fence
+	}
+	if s == nil || (len(s.keys) == 0 && !s.flushed && s.fence == c.fence) {
+		return nil, nil
+	}
+
+	changes := &pb.CacheChanges{
+		Flushed: s.flushed,
+		Fence:   c.fence,
+	}
+	for key := range s.keys {
+		e, ok, err := c.entry(key)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			changes.Entries = append(changes.Entries, e)
+		} else {
+			changes.Deleted = append(changes.Deleted, key)
+		}
+	}
+	return changes, nil
+}
+
+// ApplyChanges applies changes returned by DrainChanges on another node.
+func (c *cache) ApplyChanges(changes *pb.CacheChanges) error {
+	values := make([]dt.AnyT, len(changes.Entries))
+	for i, e := range changes.Entries {
+		v, err := dt.Decode(e.Value)
+		if err != nil {
+			return err
+		}
+		values[i] = v
+	}
+
+	now := time.Now().UnixNano()
+	c.mu.Lock()
+	if changes.Flushed {
+		c.flush()
+	}
+	for _, key := range changes.Deleted {
+		c.store.Delete(key)
+		delete(c.expList, key)
+		c.indexKey(key)
+		c.wakeWaiters(key)
+		c.notify(pb.EventType_DELETE, key)
+	}
+	for i, e := range changes.Entries {
+		c.putEntry(e, values[i], now)
+	}
+	if changes.Fence > c.fence {
+		c.fence = changes.Fence
+	}
+	c.mu.Unlock()
+	return nil
+}
+
+// runCommand runs the CacheService method with the encoded request, as
+// replicated for the methods which change more than keys.
+func (c *cache) runCommand(method string, request []byte) (interface{}, error) {
+	name := strings.TrimPrefix(method, "/CacheService/")
+	for _, m := range pb.CacheService_ServiceDesc.Methods {
+		if m.MethodName != name {
+			continue
+		}
+		dec := func(in interface{}) error {
+			return proto.Unmarshal(request, in.(proto.Message))
+		}
+		return m.Handler(c, context.Background(), dec, nil)
+	}
+	return nil, status.Errorf(codes.Unimplemented, "method %s not implemented", name)
+}
+
+// flush deletes every key, keeping the definitions of the indexes. Must
+// be called with c.mu held.
+func (c *cache) flush() {
+	c.store = ds.InitRBTree()
+	c.expList = make(map[string]int64)
+	for _, idx := range c.indexes {
+		idx.Clear()
+	}
+	for _, idx := range c.searchIndexes {
+		idx.Clear()
+	}
+	for key := range c.waiters {
+		c.wakeWaiters(key)
+	}
+	c.notify(pb.EventType_FLUSH, "")
+}
+
+// SnapshotState encodes every key of the cache along with the lock fence
+// and the definitions of the indexes.
+func (c *cache) SnapshotState() ([]byte, error) {
+	c.mu.RLock()
+	snap := &pb.CacheSnapshot{Fence: c.fence}
+	var err error
+	c.store.Ascend("", func(key string, _ interface{}) bool {
+		var e *pb.KeyEntry
+		var ok bool
+		if e, ok, err = c.entry(key); err != nil {
+			return false
+		}
+		if ok {
+			snap.Entries = append(snap.Entries, e)
+		}
+		return true
+	})
+	for _, idx := range c.indexes {
+		snap.Indexes = append(snap.Indexes, indexDefinition(idx))
+	}
+	for _, idx := range c.searchIndexes {
+		snap.SearchIndexes = append(snap.SearchIndexes, searchIndexDefinition(idx))
+	}
+	c.mu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(snap)
+}
+
+// RestoreState replaces the content of the cache with a snapshot returned
+// by SnapshotState. A nil snapshot empties the cache and drops the
+// indexes.
+func (c *cache) RestoreState(data []byte) error {
+	snap := &pb.CacheSnapshot{}
+	if err := proto.Unmarshal(data, snap); err != nil {
+		return err
+	}
+	values := make([]dt.AnyT, len(snap.Entries))
+	for i, e := range snap.Entries {
+		v, err := dt.Decode(e.Value)
+		if err != nil {
+			return err
+		}
+		values[i] = v
+	}
+
+	now := time.Now().UnixNano()
+	c.mu.Lock()
+	c.flush()
+	c.indexes = make(map[string]*dt.HashIndex)
+	c.searchIndexes = make(map[string]*dt.SearchIndex)
+	for i, e := range snap.Entries {
+		c.putEntry(e, values[i], now)
+	}
+	c.fence = snap.Fence
+	c.mu.Unlock()
+
+	// Creating the indexes indexes the restored keys.
+	for _, def := range snap.Indexes {
+		if _, err := c.CreateIndex(context.Background(), def); err != nil {
+			return err
+		}
+	}
+	for _, def := range snap.SearchIndexes {
+		if _, err := c.FTCreate(context.Background(), def); err != nil {
+			return err
+		}
+	}
+	return nil
+}
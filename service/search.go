@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
+)
+
+var ErrInvalidSearchIndex = errors.New("Invalid search index definition")
+
+// searchTexts returns the texts of value indexed by idx, or nil if idx
+// does not cover value.
+func searchTexts(idx *dt.SearchIndex, value interface{}) []string {
+	switch v := value.(type) {
+	case *dt.HashMapT:
+		var texts []string
+		for _, f := range idx.Fields {
+			if text, exists := v.Data[f]; exists {
+				texts = append(texts, text)
+			}
+		}
+		return texts
+	case *dt.StringT:
+		if idx.Strings && utf8.Valid(v.Data) {
+			return []string{string(v.Data)}
+		}
+	}
+	return nil
+}
+
+func (c *cache) FTCreate(ctx context.Context, args *pb.SearchIndexDefinition) (*pb.Response, error) {
+	if args.Name == "" || (len(args.Fields) == 0 && !args.Strings) {
+		return nil, ErrInvalidSearchIndex
+	}
+
+	c.mu.Lock()
+	if _, exists := c.searchIndexes[args.Name]; exists {
+		c.mu.Unlock()
+		return nil, ErrIndexExists
+	}
+
+	idx := dt.NewSearchIndex(args.Name, args.Prefix, args.Fields, args.Strings)
+	c.store.Ascend(args.Prefix, func(key string, value interface{}) bool {
+		if !idx.Matches(key) {
+			return false
+		}
+		if texts := searchTexts(idx, value); texts != nil {
+			idx.Update(key, texts)
+		}
+		return true
+	})
+	c.searchIndexes[args.Name] = idx
+	c.mu.Unlock()
+
+	return &pb.Response{
+		Response: true,
+	}, nil
+}
+
+func (c *cache) FTDrop(ctx context.Context, args *pb.IndexName) (*pb.Response, error) {
+	c.mu.Lock()
+	if _, exists := c.searchIndexes[args.Name]; !exists {
+		c.mu.Unlock()
+		return nil, ErrNoIndex
+	}
+	delete(c.searchIndexes, args.Name)
+	c.mu.Unlock()
+
+	return &pb.Response{
+		Response: true,
+	}, nil
+}
+
+func searchIndexDefinition(idx *dt.SearchIndex) *pb.SearchIndexDefinition {
+	return &pb.SearchIndexDefinition{
+		Name:    idx.Name,
+		Prefix:  idx.Prefix,
+		Fields:  append([]string(nil), idx.Fields...),
+		Strings: idx.Strings,
+	}
+}
+
+func (c *cache) FTInfo(ctx context.Context, args *pb.IndexName) (*pb.SearchIndexInfo, error) {
+	c.mu.RLock()
+	idx, exists := c.searchIndexes[args.Name]
+	if !exists {
+		c.mu.RUnlock()
+		return nil, ErrNoIndex
+	}
+
+	info := &pb.SearchIndexInfo{
+		Definition: searchIndexDefinition(idx),
+		Keys:       int64(idx.Len()),
+		Terms:      int64(len(idx.Postings)),
+	}
+	c.mu.RUnlock()
+
+	return info, nil
+}
+
+// FTSearch returns the keys matching args.Query, best first, along with
+// the number of matching keys.
+func (c *cache) FTSearch(ctx context.Context, args *pb.SearchQuery) (*pb.SearchResults, error) {
+	c.mu.RLock()
+	idx, exists := c.searchIndexes[args.Index]
+	if !exists {
+		c.mu.RUnlock()
+		return nil, ErrNoIndex
+	}
+	matches, err := idx.Search(args.Query)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+
+	now := time.Now().UnixNano()
+	live := matches[:0]
+	for _, m := range matches {
+		if exp := c.expList[m.Key]; exp == 0 || now <= exp {
+			live = append(live, m)
+		}
+	}
+	c.mu.RUnlock()
+
+	res := &pb.SearchResults{
+		Total: int64(len(live)),
+	}
+	limit := args.Limit
+	if limit <= 0 {
+		limit = defaultQueryLimit
+	}
+	for i := args.Offset; i >= 0 && i < int64(len(live)) && i < args.Offset+limit; i++ {
+		res.Results = append(res.Results, &pb.SearchResult{
+			Key:   live[i].Key,
+			Score: live[i].Score,
+		})
+	}
+	return res, nil
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
 	ds "github.com/shanukun/cash/ds"
 	"runtime"
 	"sync"
@@ -23,6 +24,14 @@ type cache struct {
 	mu                sync.RWMutex
 	store             *ds.RBTree
 	worker            *worker
+	fence             int64
+	waiters           map[string]chan struct{}
+	pubsub            *pubsub
+	watch             *watchHub
+	indexes           map[string]*dt.HashIndex
+	searchIndexes     map[string]*dt.SearchIndex
+	changes           *changeSet
+	sending           map[string]bool
 	pb.UnimplementedCacheServiceServer
 }
 
@@ -46,6 +55,12 @@ func newCache(defaultExpiration time.Duration, store *ds.RBTree) *cache {
 		defaultExpiration: defaultExpiration,
 		store:             store,
 		expList:           make(map[string]int64),
+		waiters:           make(map[string]chan struct{}),
+		pubsub:            newPubSub(),
+		watch:             newWatchHub(),
+		indexes:           make(map[string]*dt.HashIndex),
+		searchIndexes:     make(map[string]*dt.SearchIndex),
+		sending:           make(map[string]bool),
 	}
 	return c
 }
@@ -81,9 +96,38 @@ func (c *cache) deleteExpired() {
 	c.mu.Lock()
 	for k, v := range c.expList {
 		if v > 0 && now > v {
-			c.store.Delete(k)
-			delete(c.expList, k)
+			c.expire(k)
 		}
 	}
 	c.mu.Unlock()
 }
+
+// expire deletes key, whose time to live has run out. Must be called with
+// c.mu held.
+func (c *cache) expire(key string) {
+	c.store.Delete(key)
+	delete(c.expList, key)
+	c.indexKey(key)
+	c.wakeWaiters(key)
+	c.notify(pb.EventType_EXPIRE, key)
+}
+
+// wakeWaiters wakes up every call blocked on key, such as AcquireLock or a
+// blocking stream read. Must be called with c.mu held.
+func (c *cache) wakeWaiters(key string) {
+	if ch, ok := c.waiters[key]; ok {
+		close(ch)
+		delete(c.waiters, key)
+	}
+}
+
+// waiter returns a channel that is closed on the next change to key. Must
+// be called with c.mu held.
+func (c *cache) waiter(key string) chan struct{} {
+	ch, ok := c.waiters[key]
+	if !ok {
+		ch = make(chan struct{})
+		c.waiters[key] = ch
+	}
+	return ch
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
+)
+
+// getSet returns the set at key. Must be called with c.mu held.
+func (c *cache) getSet(key string) (*dt.SetT, error) {
+	kr := genKeyReport(c, key, setType)
+	if !kr.exists {
+		return nil, ErrNoKey
+	}
+	if !kr.typeMatch {
+		return nil, ErrWrongType
+	}
+
+	set := (kr.val).(*dt.SetT)
+	if isExpired(set.Expiration) {
+		return nil, ErrKeyExpired
+	}
+	return set, nil
+}
+
+// SAdd adds members to the set at key, creating it if it does not exist,
+// and returns the number of members which were not in the set.
+func (c *cache) SAdd(ctx context.Context, args *pb.SetMembersRequest) (*pb.Count, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	set, err := c.getSet(args.Key)
+	if err == ErrNoKey {
+		expiration := getExpiration(args.Expiration)
+		set = &dt.SetT{Data: make(map[string]bool), Expiration: expiration}
+		c.store.Insert(args.Key, dt.AnyT(set))
+		c.expList[args.Key] = expiration
+	} else if err != nil {
+		return nil, err
+	}
+
+	var added int64
+	for _, m := range args.Members {
+		if !set.Data[m] {
+			set.Data[m] = true
+			added++
+		}
+	}
+	if added > 0 {
+		c.notify(pb.EventType_UPDATE, args.Key)
+	}
+	return &pb.Count{Count: added}, nil
+}
+
+// SRem removes members from the set at key and returns the number of
+// members removed. The set is deleted once empty.
+func (c *cache) SRem(ctx context.Context, args *pb.SetMembersRequest) (*pb.Count, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	set, err := c.getSet(args.Key)
+	if err == ErrNoKey {
+		return &pb.Count{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var removed int64
+	for _, m := range args.Members {
+		if set.Data[m] {
+			delete(set.Data, m)
+			removed++
+		}
+	}
+	if len(set.Data) == 0 {
+		c.store.Delete(args.Key)
+		delete(c.expList, args.Key)
+		c.indexKey(args.Key)
+		c.wakeWaiters(args.Key)
+		c.notify(pb.EventType_DELETE, args.Key)
+	} else if removed > 0 {
+		c.notify(pb.EventType_UPDATE, args.Key)
+	}
+	return &pb.Count{Count: removed}, nil
+}
+
+// SMembers returns the members of the set at key in order.
+func (c *cache) SMembers(ctx context.Context, args *pb.Key) (*pb.List, error) {
+	c.mu.RLock()
+	set, err := c.getSet(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+	members := make([]string, 0, len(set.Data))
+	for m := range set.Data {
+		members = append(members, m)
+	}
+	expiration := set.Expiration
+	c.mu.RUnlock()
+
+	sort.Strings(members)
+	return &pb.List{
+		Key:        args.Key,
+		List:       members,
+		Expiration: time.Unix(0, expiration).String(),
+	}, nil
+}
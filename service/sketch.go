@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
+)
+
+var (
+	ErrInvalidSketch  = errors.New("Invalid sketch parameters")
+	ErrSketchMismatch = errors.New("Sketches have different dimensions")
+)
+
+const (
+	defaultTopKWidth = 8
+	defaultTopKDepth = 7
+	defaultTopKDecay = 0.9
+)
+
+// getCountMinSketch returns the count-min sketch at key. Must be called
+// with c.mu held.
+func (c *cache) getCountMinSketch(key string) (*dt.CountMinSketchT, error) {
+	kr := genKeyReport(c, key, cmsType)
+	if !kr.exists {
+		return nil, ErrNoKey
+	}
+	if !kr.typeMatch {
+		return nil, ErrWrongType
+	}
+
+	cms := (kr.val).(*dt.CountMinSketchT)
+	if isExpired(cms.Expiration) {
+		return nil, ErrKeyExpired
+	}
+	return cms, nil
+}
+
+// CMSInit creates a count-min sketch by width and depth or, if no width is
+// given, by the error bound and the probability of exceeding it.
+func (c *cache) CMSInit(ctx context.Context, args *pb.CMSInitRequest) (*pb.Response, error) {
+	width, depth := uint64(args.Width), uint64(args.Depth)
+	if args.Width <= 0 {
+		if args.Error <= 0 || args.Error >= 1 || args.Probability <= 0 || args.Probability >= 1 {
+			return nil, ErrInvalidSketch
+		}
+		width, depth = dt.CountMinDims(args.Error, args.Probability)
+	} else if args.Depth <= 0 {
+		return nil, ErrInvalidSketch
+	}
+
+	c.mu.Lock()
+	kr := genKeyReport(c, args.Key, cmsType)
+	if kr.exists && !isExpired(dt.Expiration(kr.val)) {
+		c.mu.Unlock()
+		return nil, ErrKeyExists
+	}
+	c.store.Delete(args.Key)
+
+	expiration := getExpiration(args.Expiration)
+	c.store.Insert(args.Key, dt.AnyT(dt.NewCountMinSketch(width, depth, expiration)))
+	c.expList[args.Key] = expiration
+	c.notify(pb.EventType_UPDATE, args.Key)
+	c.mu.Unlock()
+
+	return &pb.Response{
+		Response: true,
+	}, nil
+}
+
+func (c *cache) CMSIncrBy(ctx context.Context, args *pb.IncrRequest) (*pb.Counts, error) {
+	c.mu.Lock()
+	cms, err := c.getCountMinSketch(args.Key)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	res := &pb.Counts{}
+	for _, item := range args.Items {
+		if item.Increment < 0 {
+			c.mu.Unlock()
+			return nil, ErrInvalidSketch
+		}
+		count := cms.IncrBy(item.Item, uint64(item.Increment))
+		res.Counts = append(res.Counts, int64(count))
+	}
+	c.notify(pb.EventType_UPDATE, args.Key)
+	c.mu.Unlock()
+
+	return res, nil
+}
+
+func (c *cache) CMSQuery(ctx context.Context, args *pb.FilterItems) (*pb.Counts, error) {
+	c.mu.RLock()
+	cms, err := c.getCountMinSketch(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+
+	res := &pb.Counts{}
+	for _, item := range args.Items {
+		res.Counts = append(res.Counts, int64(cms.Query(item)))
+	}
+	c.mu.RUnlock()
+
+	return res, nil
+}
+
+// CMSMerge adds the sketches at sources, multiplied by their weights, to
+// dest. All sketches must have the same dimensions.
+func (c *cache) CMSMerge(ctx context.Context, args *pb.CMSMergeRequest) (*pb.Response, error) {
+	if len(args.Weights) > 0 && len(args.Weights) != len(args.Sources) {
+		return nil, ErrInvalidSketch
+	}
+
+	c.mu.Lock()
+	dest, err := c.getCountMinSketch(args.Dest)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	var sources []*dt.CountMinSketchT
+	for _, key := range args.Sources {
+		cms, err := c.getCountMinSketch(key)
+		if err != nil {
+			c.mu.Unlock()
+			return nil, err
+		}
+		if cms.Width != dest.Width || cms.Depth != dest.Depth {
+			c.mu.Unlock()
+			return nil, ErrSketchMismatch
+		}
+		sources = append(sources, cms)
+	}
+
+	// Merging a sketch into itself would double count it, so merge into a
+	// copy when dest is one of the sources.
+	merged := dt.NewCountMinSketch(dest.Width, dest.Depth, dest.Expiration)
+	merged.Merge(dest, 1)
+	for i, cms := range sources {
+		weight := uint64(1)
+		if len(args.Weights) > 0 {
+			weight = uint64(args.Weights[i])
+		}
+		merged.Merge(cms, weight)
+	}
+	dest.Counters = merged.Counters
+	dest.Count = merged.Count
+	c.notify(pb.EventType_UPDATE, args.Dest)
+	c.mu.Unlock()
+
+	return &pb.Response{
+		Response: true,
+	}, nil
+}
+
+func (c *cache) CMSInfo(ctx context.Context, args *pb.Key) (*pb.SketchInfo, error) {
+	c.mu.RLock()
+	cms, err := c.getCountMinSketch(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+
+	info := &pb.SketchInfo{
+		Width: int64(cms.Width),
+		Depth: int64(cms.Depth),
+		Count: int64(cms.Count),
+	}
+	c.mu.RUnlock()
+
+	return info, nil
+}
+
+// getTopK returns the top-k at key. Must be called with c.mu held.
+func (c *cache) getTopK(key string) (*dt.TopKT, error) {
+	kr := genKeyReport(c, key, topkType)
+	if !kr.exists {
+		return nil, ErrNoKey
+	}
+	if !kr.typeMatch {
+		return nil, ErrWrongType
+	}
+
+	topk := (kr.val).(*dt.TopKT)
+	if isExpired(topk.Expiration) {
+		return nil, ErrKeyExpired
+	}
+	return topk, nil
+}
+
+func (c *cache) TopKReserve(ctx context.Context, args *pb.TopKReserveRequest) (*pb.Response, error) {
+	if args.K <= 0 || args.Width < 0 || args.Depth < 0 || args.Decay < 0 || args.Decay >= 1 {
+		return nil, ErrInvalidSketch
+	}
+	width, depth, decay := uint64(args.Width), uint64(args.Depth), args.Decay
+	if width == 0 {
+		width = uint64(args.K) * defaultTopKWidth
+	}
+	if depth == 0 {
+		depth = defaultTopKDepth
+	}
+	if decay == 0 {
+		decay = defaultTopKDecay
+	}
+
+	c.mu.Lock()
+	kr := genKeyReport(c, args.Key, topkType)
+	if kr.exists && !isExpired(dt.Expiration(kr.val)) {
+		c.mu.Unlock()
+		return nil, ErrKeyExists
+	}
+	c.store.Delete(args.Key)
+
+	expiration := getExpiration(args.Expiration)
+	c.store.Insert(args.Key, dt.AnyT(dt.NewTopK(uint64(args.K), width, depth, decay, expiration)))
+	c.expList[args.Key] = expiration
+	c.notify(pb.EventType_UPDATE, args.Key)
+	c.mu.Unlock()
+
+	return &pb.Response{
+		Response: true,
+	}, nil
+}
+
+// TopKIncrBy increments the counts of items and returns the items that
+// were pushed out of the top-k list.
+func (c *cache) TopKIncrBy(ctx context.Context, args *pb.IncrRequest) (*pb.ItemList, error) {
+	c.mu.Lock()
+	topk, err := c.getTopK(args.Key)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	res := &pb.ItemList{}
+	for _, item := range args.Items {
+		if item.Increment < 0 {
+			c.mu.Unlock()
+			return nil, ErrInvalidSketch
+		}
+		if expelled, ok := topk.IncrBy(item.Item, uint64(item.Increment)); ok {
+			res.Items = append(res.Items, expelled)
+		}
+	}
+	c.notify(pb.EventType_UPDATE, args.Key)
+	c.mu.Unlock()
+
+	return res, nil
+}
+
+func (c *cache) TopKQuery(ctx context.Context, args *pb.FilterItems) (*pb.Results, error) {
+	c.mu.RLock()
+	topk, err := c.getTopK(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+
+	res := &pb.Results{}
+	for _, item := range args.Items {
+		res.Results = append(res.Results, topk.Contains(item))
+	}
+	c.mu.RUnlock()
+
+	return res, nil
+}
+
+func (c *cache) TopKList(ctx context.Context, args *pb.Key) (*pb.TopKItems, error) {
+	c.mu.RLock()
+	topk, err := c.getTopK(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+
+	res := &pb.TopKItems{}
+	for _, item := range topk.List() {
+		res.Items = append(res.Items, &pb.TopKItem{
+			Item:  item.Item,
+			Count: int64(item.Count),
+		})
+	}
+	c.mu.RUnlock()
+
+	return res, nil
+}
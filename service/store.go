@@ -3,17 +3,20 @@ package service
 import (
 	"context"
 	"errors"
+	"strconv"
 	"time"
+	"unicode/utf8"
 
 	"github.com/golang/protobuf/ptypes/empty"
 	pb "github.com/shanukun/cash/cash_proto"
 	dt "github.com/shanukun/cash/datatypes"
-	"github.com/shanukun/cash/ds"
 )
 
 var (
 	ErrNoKey      = errors.New("No key found")
 	ErrKeyExpired = errors.New("Key expired")
+	ErrWrongType  = errors.New("Key holds a different type")
+	ErrNotInteger = errors.New("Value is not an integer")
 )
 
 func getExpiration(expiration string) int64 {
@@ -35,6 +38,42 @@ func isExpired(expiration int64) bool {
 	return false
 }
 
+const (
+	stringType = iota
+	listType
+	hashMapType
+	lockType
+	streamType
+	hllType
+	bloomType
+	cuckooType
+	cmsType
+	topkType
+	geoType
+	jsonType
+	timeSeriesType
+	vectorType
+	setType
+)
+
+// stringBytes returns the value of a request, preferring the binary raw
+// value if one is set.
+func stringBytes(value string, raw []byte) []byte {
+	if len(raw) > 0 {
+		return raw
+	}
+	return []byte(value)
+}
+
+// splitBytes returns a copy of data as a string if it is valid UTF-8 and
+// as raw bytes otherwise, since protobuf strings can not hold binary data.
+func splitBytes(data []byte) (string, []byte) {
+	if utf8.Valid(data) {
+		return string(data), nil
+	}
+	return "", append([]byte(nil), data...)
+}
+
 type keyReport struct {
 	val       dt.AnyT
 	exists    bool
@@ -46,12 +85,36 @@ func genKeyReport(c *cache, key string, t int) *keyReport {
 	p, exists := c.store.Find(key)
 	if exists {
 		switch t {
-		case 0:
+		case stringType:
 			_, typeMatch = p.(*dt.StringT)
-		case 1:
+		case listType:
 			_, typeMatch = p.(*dt.ListT)
-		case 2:
+		case hashMapType:
 			_, typeMatch = p.(*dt.HashMapT)
+		case lockType:
+			_, typeMatch = p.(*dt.LockT)
+		case streamType:
+			_, typeMatch = p.(*dt.StreamT)
+		case hllType:
+			_, typeMatch = p.(*dt.HyperLogLogT)
+		case bloomType:
+			_, typeMatch = p.(*dt.BloomT)
+		case cuckooType:
+			_, typeMatch = p.(*dt.CuckooT)
+		case cmsType:
+			_, typeMatch = p.(*dt.CountMinSketchT)
+		case topkType:
+			_, typeMatch = p.(*dt.TopKT)
+		case geoType:
+			_, typeMatch = p.(*dt.GeoT)
+		case jsonType:
+			_, typeMatch = p.(*dt.JSONT)
+		case timeSeriesType:
+			_, typeMatch = p.(*dt.TimeSeriesT)
+		case vectorType:
+			_, typeMatch = p.(*dt.VectorT)
+		case setType:
+			_, typeMatch = p.(*dt.SetT)
 		}
 
 	}
@@ -68,19 +131,25 @@ func (c *cache) Set(ctx context.Context, item *pb.String) (*pb.Response, error)
 
 	c.expList[item.Key] = expiration
 
-	kr := genKeyReport(c, item.Key, 0)
+	kr := genKeyReport(c, item.Key, stringType)
 	if !kr.exists {
 		stringData := &dt.StringT{
-			Data:       item.Value,
+			Data:       stringBytes(item.Value, item.Raw),
 			Expiration: expiration,
+			Version:    1,
 		}
 		anyT := dt.AnyT(stringData)
 
 		c.store.Insert(item.Key, anyT)
 	} else if kr.typeMatch {
 		stringValue := (kr.val).(*dt.StringT)
-		stringValue.Data = item.Value
+		stringValue.Data = stringBytes(item.Value, item.Raw)
 		stringValue.Expiration = getExpiration(item.Expiration)
+		stringValue.Version++
+	}
+	if !kr.exists || kr.typeMatch {
+		c.indexKey(item.Key)
+		c.notify(pb.EventType_SET, item.Key)
 	}
 	c.mu.Unlock()
 	return &pb.Response{
@@ -88,10 +157,128 @@ func (c *cache) Set(ctx context.Context, item *pb.String) (*pb.Response, error)
 	}, nil
 }
 
+// setAllowed reports whether the conditions of item hold for the current
+// value, nil meaning the key is absent or expired. An expected version of
+// zero matches an absent key.
+func setAllowed(item *pb.SetItem, current *dt.StringT) bool {
+	switch item.Mode {
+	case pb.SetMode_NX:
+		if current != nil {
+			return false
+		}
+	case pb.SetMode_XX:
+		if current == nil {
+			return false
+		}
+	}
+
+	switch cmp := item.Compare.(type) {
+	case *pb.SetItem_ExpectedValue:
+		return current != nil && string(current.Data) == cmp.ExpectedValue
+	case *pb.SetItem_ExpectedVersion:
+		var version int64
+		if current != nil {
+			version = current.Version
+		}
+		return version == cmp.ExpectedVersion
+	}
+	return true
+}
+
+func (c *cache) setString(item *pb.SetItem) (*pb.SetResult, error) {
+	c.mu.Lock()
+	kr := genKeyReport(c, item.Key, stringType)
+	if kr.exists && !kr.typeMatch {
+		c.mu.Unlock()
+		return nil, ErrWrongType
+	}
+
+	var current *dt.StringT
+	if kr.exists {
+		current = (kr.val).(*dt.StringT)
+		if isExpired(current.Expiration) {
+			current = nil
+		}
+	}
+
+	result := &pb.SetResult{}
+	if current != nil {
+		result.Exists = true
+		result.Version = current.Version
+		if item.Get {
+			result.Previous, result.PreviousRaw = splitBytes(current.Data)
+		}
+	}
+
+	if !setAllowed(item, current) {
+		c.mu.Unlock()
+		return result, nil
+	}
+
+	expiration := getExpiration(item.Expiration)
+	if current != nil && item.KeepTtl {
+		expiration = current.Expiration
+	}
+	c.expList[item.Key] = expiration
+
+	if current != nil {
+		current.Data = []byte(item.Value)
+		current.Expiration = expiration
+		current.Version++
+		result.Version = current.Version
+	} else if kr.exists {
+		stringValue := (kr.val).(*dt.StringT)
+		stringValue.Data = []byte(item.Value)
+		stringValue.Expiration = expiration
+		stringValue.Version = 1
+		result.Version = 1
+	} else {
+		stringData := &dt.StringT{
+			Data:       []byte(item.Value),
+			Expiration: expiration,
+			Version:    1,
+		}
+		c.store.Insert(item.Key, dt.AnyT(stringData))
+		result.Version = 1
+	}
+	c.indexKey(item.Key)
+	c.notify(pb.EventType_SET, item.Key)
+	c.mu.Unlock()
+
+	result.Response = true
+	return result, nil
+}
+
+func (c *cache) SetCond(ctx context.Context, item *pb.SetItem) (*pb.SetResult, error) {
+	return c.setString(item)
+}
+
+func (c *cache) GetSet(ctx context.Context, item *pb.String) (*pb.String, error) {
+	res, err := c.setString(&pb.SetItem{
+		Key:        item.Key,
+		Value:      string(stringBytes(item.Value, item.Raw)),
+		Expiration: item.Expiration,
+		Get:        true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	prev := &pb.String{
+		Key: item.Key,
+	}
+	if res.Exists {
+		prev.Value = res.Previous
+		prev.Raw = res.PreviousRaw
+		prev.Version = res.Version - 1
+	}
+	return prev, nil
+}
+
 func (c *cache) Get(ctx context.Context, args *pb.Key) (*pb.String, error) {
 	key := args.Key
 	c.mu.RLock()
-	kr := genKeyReport(c, key, 0)
+	kr := genKeyReport(c, key, stringType)
 	if !kr.exists || !kr.typeMatch {
 		c.mu.RUnlock()
 		return nil, ErrNoKey
@@ -104,18 +291,27 @@ func (c *cache) Get(ctx context.Context, args *pb.Key) (*pb.String, error) {
 		return nil, ErrKeyExpired
 	}
 
+	value, raw := splitBytes(stringValue.Data)
 	c.mu.RUnlock()
 
 	return &pb.String{
 		Key:        key,
-		Value:      stringValue.Data,
+		Value:      value,
 		Expiration: time.Unix(0, stringValue.Expiration).String(),
+		Version:    stringValue.Version,
+		Raw:        raw,
 	}, nil
 }
 
 func (c *cache) DeleteKey(ctx context.Context, args *pb.Key) (*pb.Response, error) {
 	c.mu.Lock()
-	c.store.Delete(args.Key)
+	if _, exists := c.store.Find(args.Key); exists {
+		c.store.Delete(args.Key)
+		delete(c.expList, args.Key)
+		c.indexKey(args.Key)
+		c.wakeWaiters(args.Key)
+		c.notify(pb.EventType_DELETE, args.Key)
+	}
 	c.mu.Unlock()
 
 	return &pb.Response{
@@ -123,12 +319,59 @@ func (c *cache) DeleteKey(ctx context.Context, args *pb.Key) (*pb.Response, erro
 	}, nil
 }
 
+// IncrBy adds item.Delta to the integer held by a string, created as zero
+// if missing, and returns the result.
+func (c *cache) IncrBy(ctx context.Context, item *pb.CounterRequest) (*pb.Count, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	kr := genKeyReport(c, item.Key, stringType)
+	if kr.exists && !kr.typeMatch {
+		return nil, ErrWrongType
+	}
+
+	var current *dt.StringT
+	if kr.exists {
+		current = (kr.val).(*dt.StringT)
+		if isExpired(current.Expiration) {
+			current = nil
+		}
+	}
+	var value int64
+	if current != nil {
+		v, err := strconv.ParseInt(string(current.Data), 10, 64)
+		if err != nil {
+			return nil, ErrNotInteger
+		}
+		value = v
+	}
+	value += item.Delta
+
+	data := []byte(strconv.FormatInt(value, 10))
+	if current != nil {
+		current.Data = data
+		current.Version++
+	} else {
+		expiration := getExpiration(item.Expiration)
+		c.store.Delete(item.Key)
+		c.store.Insert(item.Key, dt.AnyT(&dt.StringT{
+			Data:       data,
+			Expiration: expiration,
+			Version:    1,
+		}))
+		c.expList[item.Key] = expiration
+	}
+	c.indexKey(item.Key)
+	c.notify(pb.EventType_UPDATE, item.Key)
+
+	return &pb.Count{Count: value}, nil
+}
+
 func (c *cache) LPush(ctx context.Context, item *pb.String) (*pb.Response, error) {
 	expiration := getExpiration(item.Expiration)
 	key := item.Key
 
-	c.mu.RLock()
-	kr := genKeyReport(c, key, 1)
+	c.mu.Lock()
+	kr := genKeyReport(c, key, listType)
 	if !kr.exists {
 		c.expList[item.Key] = expiration
 
@@ -143,13 +386,16 @@ func (c *cache) LPush(ctx context.Context, item *pb.String) (*pb.Response, error
 		list := (kr.val).(*dt.ListT)
 
 		if isExpired(list.Expiration) {
-			c.mu.RUnlock()
+			c.mu.Unlock()
 			return nil, ErrKeyExpired
 		}
 
 		list.Data = append([]string{item.Value}, list.Data...)
 	}
-	c.mu.RUnlock()
+	if !kr.exists || kr.typeMatch {
+		c.notify(pb.EventType_PUSH, key)
+	}
+	c.mu.Unlock()
 
 	return &pb.Response{
 		Response: true,
@@ -159,8 +405,8 @@ func (c *cache) LPush(ctx context.Context, item *pb.String) (*pb.Response, error
 func (c *cache) RPush(ctx context.Context, item *pb.String) (*pb.Response, error) {
 	expiration := getExpiration(item.Expiration)
 	key := item.Key
-	c.mu.RLock()
-	kr := genKeyReport(c, key, 1)
+	c.mu.Lock()
+	kr := genKeyReport(c, key, listType)
 	if !kr.exists {
 		c.expList[item.Key] = expiration
 
@@ -175,13 +421,16 @@ func (c *cache) RPush(ctx context.Context, item *pb.String) (*pb.Response, error
 		list := (kr.val).(*dt.ListT)
 
 		if isExpired(list.Expiration) {
-			c.mu.RUnlock()
+			c.mu.Unlock()
 			return nil, ErrKeyExpired
 		}
 
 		list.Data = append(list.Data, item.Value)
 	}
-	c.mu.RUnlock()
+	if !kr.exists || kr.typeMatch {
+		c.notify(pb.EventType_PUSH, key)
+	}
+	c.mu.Unlock()
 
 	return &pb.Response{
 		Response: true,
@@ -191,7 +440,7 @@ func (c *cache) RPush(ctx context.Context, item *pb.String) (*pb.Response, error
 func (c *cache) GetList(ctx context.Context, args *pb.Key) (*pb.List, error) {
 	key := args.Key
 	c.mu.RLock()
-	kr := genKeyReport(c, key, 1)
+	kr := genKeyReport(c, key, listType)
 	if !kr.exists || !kr.typeMatch {
 		c.mu.RUnlock()
 		return nil, ErrNoKey
@@ -215,9 +464,9 @@ func (c *cache) GetList(ctx context.Context, args *pb.Key) (*pb.List, error) {
 func (c *cache) HMSet(ctx context.Context, item *pb.HashMapItem) (*pb.Response, error) {
 	expiration := getExpiration(item.Expiration)
 	key := item.Key
-	c.mu.RLock()
+	c.mu.Lock()
 
-	kr := genKeyReport(c, key, 2)
+	kr := genKeyReport(c, key, hashMapType)
 	if !kr.exists {
 		c.expList[item.Key] = expiration
 		newHashMap := &dt.HashMapT{
@@ -233,14 +482,18 @@ func (c *cache) HMSet(ctx context.Context, item *pb.HashMapItem) (*pb.Response,
 		hashMap := (kr.val).(*dt.HashMapT)
 
 		if isExpired(hashMap.Expiration) {
-			c.mu.RUnlock()
+			c.mu.Unlock()
 			return nil, ErrKeyExpired
 		}
 
 		hashMap.Data[item.Field] = item.Value
 
 	}
-	c.mu.RUnlock()
+	if !kr.exists || kr.typeMatch {
+		c.indexKey(key)
+		c.notify(pb.EventType_HSET, key)
+	}
+	c.mu.Unlock()
 
 	return &pb.Response{
 		Response: true,
@@ -249,7 +502,7 @@ func (c *cache) HMSet(ctx context.Context, item *pb.HashMapItem) (*pb.Response,
 
 func (c *cache) GetHashMap(ctx context.Context, args *pb.Key) (*pb.List, error) {
 	c.mu.RLock()
-	kr := genKeyReport(c, args.Key, 2)
+	kr := genKeyReport(c, args.Key, hashMapType)
 	if !kr.exists || !kr.typeMatch {
 		c.mu.RUnlock()
 		return nil, ErrNoKey
@@ -277,7 +530,9 @@ func (c *cache) GetHashMap(ctx context.Context, args *pb.Key) (*pb.List, error)
 }
 
 func (c *cache) DeleteAll(ctx context.Context, in *empty.Empty) (*pb.Response, error) {
-	c.store = ds.InitRBTree()
+	c.mu.Lock()
+	c.flush()
+	c.mu.Unlock()
 	return &pb.Response{
 		Response: true,
 	}, nil
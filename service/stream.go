@@ -0,0 +1,489 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
+)
+
+var (
+	ErrInvalidStreamID  = errors.New("Invalid stream ID")
+	ErrEmptyEntry       = errors.New("Stream entry has no fields")
+	ErrStreamIDTooSmall = errors.New("Stream ID must be greater than the last ID")
+	ErrNoGroup          = errors.New("No consumer group found")
+	ErrGroupExists      = errors.New("Consumer group already exists")
+)
+
+// waitLocked blocks until key changes, deadline passes or ctx is done.
+// It must be called with c.mu held; the lock is released while waiting
+// and held again when it returns.
+func (c *cache) waitLocked(ctx context.Context, key string, deadline time.Time) error {
+	changed := c.waiter(key)
+	c.mu.Unlock()
+
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+
+	var err error
+	select {
+	case <-changed:
+	case <-timer.C:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	c.mu.Lock()
+	return err
+}
+
+// getStream returns the stream at key. Must be called with c.mu held.
+func (c *cache) getStream(key string) (*dt.StreamT, error) {
+	kr := genKeyReport(c, key, streamType)
+	if !kr.exists {
+		return nil, ErrNoKey
+	}
+	if !kr.typeMatch {
+		return nil, ErrWrongType
+	}
+
+	stream := (kr.val).(*dt.StreamT)
+	if isExpired(stream.Expiration) {
+		return nil, ErrKeyExpired
+	}
+	return stream, nil
+}
+
+// createStream returns the stream at key, creating it if it does not exist.
+// Must be called with c.mu held.
+func (c *cache) createStream(key string, expiration int64) (*dt.StreamT, error) {
+	stream, err := c.getStream(key)
+	if err != ErrNoKey {
+		return stream, err
+	}
+
+	stream = dt.NewStream(expiration)
+	c.store.Insert(key, dt.AnyT(stream))
+	c.expList[key] = expiration
+	return stream, nil
+}
+
+func parseRangeStart(s string) (dt.StreamID, error) {
+	if s == "" || s == "-" {
+		return dt.StreamID{}, nil
+	}
+	return dt.ParseStreamID(s, 0)
+}
+
+func parseRangeEnd(s string) (dt.StreamID, error) {
+	if s == "" || s == "+" {
+		return dt.MaxStreamID, nil
+	}
+	return dt.ParseStreamID(s, math.MaxUint64)
+}
+
+func toPbEntries(key string, entries []dt.StreamEntry) *pb.StreamEntries {
+	res := &pb.StreamEntries{
+		Key: key,
+	}
+	for _, e := range entries {
+		res.Entries = append(res.Entries, &pb.StreamEntry{
+			Id:     e.ID.String(),
+			Fields: e.Fields,
+		})
+	}
+	return res
+}
+
+func nowMs() uint64 {
+	return uint64(time.Now().UnixMilli())
+}
+
+func (c *cache) XAdd(ctx context.Context, args *pb.StreamAddRequest) (*pb.StreamID, error) {
+	if len(args.Fields) == 0 {
+		return nil, ErrEmptyEntry
+	}
+
+	c.mu.Lock()
+	stream, err := c.createStream(args.Key, getExpiration(args.Expiration))
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	var id dt.StreamID
+	switch {
+	case args.Id == "" || args.Id == "*":
+		id = stream.NextID(nowMs())
+	case strings.HasSuffix(args.Id, "-*"):
+		id, err = dt.ParseStreamID(strings.TrimSuffix(args.Id, "-*"), 0)
+		if err == nil && id.Ms == stream.LastID.Ms {
+			id.Seq = stream.LastID.Seq + 1
+		}
+	default:
+		id, err = dt.ParseStreamID(args.Id, 0)
+	}
+	if err != nil {
+		c.mu.Unlock()
+		return nil, ErrInvalidStreamID
+	}
+
+	if !stream.Append(id, args.Fields) {
+		c.mu.Unlock()
+		return nil, ErrStreamIDTooSmall
+	}
+	if args.MaxLen > 0 {
+		stream.Trim(int(args.MaxLen))
+	}
+	c.wakeWaiters(args.Key)
+	c.notify(pb.EventType_XADD, args.Key)
+	c.mu.Unlock()
+
+	return &pb.StreamID{
+		Id: id.String(),
+	}, nil
+}
+
+func (c *cache) XLen(ctx context.Context, args *pb.Key) (*pb.Count, error) {
+	c.mu.RLock()
+	stream, err := c.getStream(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+	count := len(stream.Entries)
+	c.mu.RUnlock()
+
+	return &pb.Count{
+		Count: int64(count),
+	}, nil
+}
+
+func (c *cache) XRange(ctx context.Context, args *pb.StreamRangeRequest) (*pb.StreamEntries, error) {
+	start, err := parseRangeStart(args.Start)
+	if err != nil {
+		return nil, ErrInvalidStreamID
+	}
+	end, err := parseRangeEnd(args.End)
+	if err != nil {
+		return nil, ErrInvalidStreamID
+	}
+
+	c.mu.RLock()
+	stream, err := c.getStream(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+	entries := stream.Range(start, end, int(args.Count), args.Reverse)
+	c.mu.RUnlock()
+
+	return toPbEntries(args.Key, entries), nil
+}
+
+func (c *cache) XTrim(ctx context.Context, args *pb.StreamTrimRequest) (*pb.Count, error) {
+	c.mu.Lock()
+	stream, err := c.getStream(args.Key)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+	removed := stream.Trim(int(args.MaxLen))
+	if removed > 0 {
+		c.notify(pb.EventType_UPDATE, args.Key)
+	}
+	c.mu.Unlock()
+
+	return &pb.Count{
+		Count: int64(removed),
+	}, nil
+}
+
+// XRead returns entries with an id greater than args.Id ("$" for entries
+// added after the call). If there are none and args.Block is set, it
+// blocks until new entries are added or the block duration passes.
+func (c *cache) XRead(ctx context.Context, args *pb.StreamReadRequest) (*pb.StreamEntries, error) {
+	block, _ := time.ParseDuration(args.Block)
+	deadline := time.Now().Add(block)
+
+	c.mu.Lock()
+	var after dt.StreamID
+	switch args.Id {
+	case "", "0":
+	case "$":
+		if stream, err := c.getStream(args.Key); err == nil {
+			after = stream.LastID
+		}
+	default:
+		var err error
+		after, err = dt.ParseStreamID(args.Id, 0)
+		if err != nil {
+			c.mu.Unlock()
+			return nil, ErrInvalidStreamID
+		}
+	}
+
+	for {
+		stream, err := c.getStream(args.Key)
+		if err != nil && err != ErrNoKey {
+			c.mu.Unlock()
+			return nil, err
+		}
+
+		var entries []dt.StreamEntry
+		if stream != nil {
+			entries = stream.After(after, int(args.Count))
+		}
+		if len(entries) > 0 || !time.Now().Before(deadline) {
+			c.mu.Unlock()
+			return toPbEntries(args.Key, entries), nil
+		}
+
+		if err := c.waitLocked(ctx, args.Key, deadline); err != nil {
+			c.mu.Unlock()
+			return nil, err
+		}
+	}
+}
+
+func (c *cache) XGroupCreate(ctx context.Context, args *pb.StreamGroupRequest) (*pb.Response, error) {
+	c.mu.Lock()
+	stream, err := c.getStream(args.Key)
+	if err == ErrNoKey && args.Mkstream {
+		stream, err = c.createStream(args.Key, 0)
+	}
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	var start dt.StreamID
+	switch args.Id {
+	case "", "0":
+	case "$":
+		start = stream.LastID
+	default:
+		start, err = dt.ParseStreamID(args.Id, 0)
+		if err != nil {
+			c.mu.Unlock()
+			return nil, ErrInvalidStreamID
+		}
+	}
+
+	if !stream.CreateGroup(args.Group, start) {
+		c.mu.Unlock()
+		return nil, ErrGroupExists
+	}
+	c.notify(pb.EventType_UPDATE, args.Key)
+	c.mu.Unlock()
+
+	return &pb.Response{
+		Response: true,
+	}, nil
+}
+
+// getGroup returns the stream and consumer group at key. Must be called
+// with c.mu held.
+func (c *cache) getGroup(key, group string) (*dt.StreamT, *dt.ConsumerGroup, error) {
+	stream, err := c.getStream(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	g, ok := stream.Groups[group]
+	if !ok {
+		return nil, nil, ErrNoGroup
+	}
+	return stream, g, nil
+}
+
+// pendingEntries returns the entries for ids, dropping pending ids whose
+// entries have been trimmed from the stream, so fewer entries than ids
+// means that the group changed.
+func pendingEntries(stream *dt.StreamT, g *dt.ConsumerGroup, ids []dt.StreamID) []dt.StreamEntry {
+	var entries []dt.StreamEntry
+	for _, id := range ids {
+		e, ok := stream.Get(id)
+		if !ok {
+			delete(g.Pending, id)
+			continue
+		}
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+// XReadGroup delivers new entries to a consumer of the group when args.Id
+// is ">" (or empty), adding them to the consumer's pending entries. Any
+// other id returns the consumer's pending entries after that id.
+func (c *cache) XReadGroup(ctx context.Context, args *pb.StreamReadGroupRequest) (*pb.StreamEntries, error) {
+	block, _ := time.ParseDuration(args.Block)
+	deadline := time.Now().Add(block)
+	history := args.Id != "" && args.Id != ">"
+
+	var after dt.StreamID
+	if history {
+		var err error
+		after, err = dt.ParseStreamID(args.Id, 0)
+		if err != nil {
+			return nil, ErrInvalidStreamID
+		}
+	}
+
+	c.mu.Lock()
+	for {
+		stream, g, err := c.getGroup(args.Key, args.Group)
+		if err != nil {
+			c.mu.Unlock()
+			return nil, err
+		}
+		now := time.Now().UnixNano()
+		_, known := g.Consumers[args.Consumer]
+		g.Consumers[args.Consumer] = now
+
+		if history {
+			var ids []dt.StreamID
+			for _, id := range g.PendingIDs(args.Consumer) {
+				if after.Less(id) && (args.Count <= 0 || len(ids) < int(args.Count)) {
+					ids = append(ids, id)
+				}
+			}
+			entries := pendingEntries(stream, g, ids)
+			if !known || len(entries) < len(ids) {
+				c.notify(pb.EventType_UPDATE, args.Key)
+			}
+			c.mu.Unlock()
+			return toPbEntries(args.Key, entries), nil
+		}
+
+		entries := stream.After(g.LastDelivered, int(args.Count))
+		for _, e := range entries {
+			g.Pending[e.ID] = &dt.PendingEntry{
+				Consumer:   args.Consumer,
+				Delivered:  now,
+				Deliveries: 1,
+			}
+			g.LastDelivered = e.ID
+		}
+		if !known || len(entries) > 0 {
+			c.notify(pb.EventType_UPDATE, args.Key)
+		}
+		if len(entries) > 0 || !time.Now().Before(deadline) {
+			c.mu.Unlock()
+			return toPbEntries(args.Key, entries), nil
+		}
+
+		if err := c.waitLocked(ctx, args.Key, deadline); err != nil {
+			c.mu.Unlock()
+			return nil, err
+		}
+	}
+}
+
+func (c *cache) XAck(ctx context.Context, args *pb.StreamAckRequest) (*pb.Count, error) {
+	c.mu.Lock()
+	_, g, err := c.getGroup(args.Key, args.Group)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	var acked int64
+	for _, s := range args.Ids {
+		id, err := dt.ParseStreamID(s, 0)
+		if err != nil {
+			c.mu.Unlock()
+			return nil, ErrInvalidStreamID
+		}
+		if _, ok := g.Pending[id]; ok {
+			delete(g.Pending, id)
+			acked++
+		}
+	}
+	if acked > 0 {
+		c.notify(pb.EventType_UPDATE, args.Key)
+	}
+	c.mu.Unlock()
+
+	return &pb.Count{
+		Count: acked,
+	}, nil
+}
+
+func (c *cache) XPending(ctx context.Context, args *pb.StreamPendingRequest) (*pb.StreamPendingList, error) {
+	c.mu.RLock()
+	_, g, err := c.getGroup(args.Key, args.Group)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+
+	now := time.Now().UnixNano()
+	res := &pb.StreamPendingList{}
+	for _, id := range g.PendingIDs(args.Consumer) {
+		p := g.Pending[id]
+		res.Entries = append(res.Entries, &pb.StreamPendingEntry{
+			Id:         id.String(),
+			Consumer:   p.Consumer,
+			IdleMs:     (now - p.Delivered) / int64(time.Millisecond),
+			Deliveries: p.Deliveries,
+		})
+	}
+	c.mu.RUnlock()
+
+	return res, nil
+}
+
+// XClaim transfers pending entries that have been idle for at least
+// args.MinIdle to args.Consumer. If no ids are given, up to args.Count of
+// the oldest stale pending entries of the group are claimed.
+func (c *cache) XClaim(ctx context.Context, args *pb.StreamClaimRequest) (*pb.StreamEntries, error) {
+	minIdle, _ := time.ParseDuration(args.MinIdle)
+
+	var ids []dt.StreamID
+	for _, s := range args.Ids {
+		id, err := dt.ParseStreamID(s, 0)
+		if err != nil {
+			return nil, ErrInvalidStreamID
+		}
+		ids = append(ids, id)
+	}
+
+	c.mu.Lock()
+	stream, g, err := c.getGroup(args.Key, args.Group)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+	if len(args.Ids) == 0 {
+		ids = g.PendingIDs("")
+	}
+
+	now := time.Now().UnixNano()
+	var claimed []dt.StreamID
+	for _, id := range ids {
+		if args.Count > 0 && len(claimed) >= int(args.Count) {
+			break
+		}
+		p, ok := g.Pending[id]
+		if !ok || time.Duration(now-p.Delivered) < minIdle {
+			continue
+		}
+		p.Consumer = args.Consumer
+		p.Delivered = now
+		p.Deliveries++
+		claimed = append(claimed, id)
+	}
+	_, known := g.Consumers[args.Consumer]
+	g.Consumers[args.Consumer] = now
+	entries := pendingEntries(stream, g, claimed)
+	if !known || len(claimed) > 0 {
+		c.notify(pb.EventType_UPDATE, args.Key)
+	}
+	c.mu.Unlock()
+
+	return toPbEntries(args.Key, entries), nil
+}
@@ -0,0 +1,328 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
+)
+
+var (
+	ErrInvalidTimestamp = errors.New("Timestamp must not be negative")
+	ErrInvalidRule      = errors.New("Rule needs an aggregation and a bucket duration")
+	ErrRuleExists       = errors.New("Compaction rule already exists")
+	ErrNoRule           = errors.New("No compaction rule found")
+	ErrRuleChain        = errors.New("Compaction rules can not be chained")
+	ErrNoSamples        = errors.New("Time series has no samples")
+)
+
+// getTimeSeries returns the time series at key. Must be called with c.mu
+// held.
+func (c *cache) getTimeSeries(key string) (*dt.TimeSeriesT, error) {
+	kr := genKeyReport(c, key, timeSeriesType)
+	if !kr.exists {
+		return nil, ErrNoKey
+	}
+	if !kr.typeMatch {
+		return nil, ErrWrongType
+	}
+
+	ts := (kr.val).(*dt.TimeSeriesT)
+	if isExpired(ts.Expiration) {
+		return nil, ErrKeyExpired
+	}
+	return ts, nil
+}
+
+func durationMs(s string) int64 {
+	d, _ := time.ParseDuration(s)
+	return d.Milliseconds()
+}
+
+func (c *cache) TSCreate(ctx context.Context, args *pb.TSCreateRequest) (*pb.Response, error) {
+	c.mu.Lock()
+	kr := genKeyReport(c, args.Key, timeSeriesType)
+	if kr.exists && !isExpired(dt.Expiration(kr.val)) {
+		c.mu.Unlock()
+		return nil, ErrKeyExists
+	}
+	c.store.Delete(args.Key)
+
+	expiration := getExpiration(args.Expiration)
+	ts := dt.NewTimeSeries(durationMs(args.Retention), args.Labels, dt.DuplicatePolicy(args.DuplicatePolicy), expiration)
+	c.store.Insert(args.Key, dt.AnyT(ts))
+	c.expList[args.Key] = expiration
+	c.notify(pb.EventType_UPDATE, args.Key)
+	c.mu.Unlock()
+
+	return &pb.Response{
+		Response: true,
+	}, nil
+}
+
+// TSAdd adds a sample at args.Timestamp in milliseconds, or now if it is
+// zero, creating the series if it does not exist. Samples downsampled by
+// the compaction rules of the series are added to their destinations, and
+// the rules of destinations which no longer hold a series are deleted. An
+// error is returned if a destination refuses a sample, though the sample
+// is still added to the series.
+func (c *cache) TSAdd(ctx context.Context, args *pb.TSAddRequest) (*pb.Sample, error) {
+	if args.Timestamp < 0 {
+		return nil, ErrInvalidTimestamp
+	}
+	timestamp := args.Timestamp
+	if timestamp == 0 {
+		timestamp = time.Now().UnixMilli()
+	}
+
+	c.mu.Lock()
+	ts, err := c.getTimeSeries(args.Key)
+	if err == ErrNoKey {
+		ts = dt.NewTimeSeries(0, nil, dt.DupBlock, 0)
+		c.store.Insert(args.Key, dt.AnyT(ts))
+		c.expList[args.Key] = 0
+		err = nil
+	}
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	policy := ts.DuplicatePolicy
+	if args.Override {
+		policy = dt.DuplicatePolicy(args.OnDuplicate)
+	}
+	compacted, err := ts.Add(dt.Sample{Ts: timestamp, Val: args.Value}, policy)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+	c.notify(pb.EventType_UPDATE, args.Key)
+
+	for key, samples := range compacted {
+		dest, destErr := c.getTimeSeries(key)
+		if destErr != nil {
+			// The destination was deleted or replaced, which deletes
+			// its rule.
+			ts.DeleteRule(key)
+			continue
+		}
+		for _, s := range samples {
+			if _, addErr := dest.Add(s, dt.DupLast); addErr != nil && err == nil {
+				err = addErr
+			}
+		}
+		c.notify(pb.EventType_UPDATE, key)
+	}
+	c.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Sample{
+		Timestamp: timestamp,
+		Value:     args.Value,
+	}, nil
+}
+
+// TSGet returns the latest sample.
+func (c *cache) TSGet(ctx context.Context, args *pb.Key) (*pb.Sample, error) {
+	c.mu.RLock()
+	ts, err := c.getTimeSeries(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+	last, ok := ts.Last()
+	c.mu.RUnlock()
+	if !ok {
+		return nil, ErrNoSamples
+	}
+
+	return &pb.Sample{
+		Timestamp: last.Ts,
+		Value:     last.Val,
+	}, nil
+}
+
+// TSRange returns the samples between args.From and args.To inclusive, up
+// to the latest sample if args.To is zero. With an aggregation, samples
+// are aggregated into buckets of args.Bucket.
+func (c *cache) TSRange(ctx context.Context, args *pb.TSRangeRequest) (*pb.Samples, error) {
+	to := args.To
+	if to <= 0 {
+		to = math.MaxInt64
+	}
+
+	c.mu.RLock()
+	ts, err := c.getTimeSeries(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+	samples := ts.Range(args.From, to, dt.Aggregation(args.Aggregation), durationMs(args.Bucket))
+	c.mu.RUnlock()
+
+	if args.Reverse {
+		for i, j := 0, len(samples)-1; i < j; i, j = i+1, j-1 {
+			samples[i], samples[j] = samples[j], samples[i]
+		}
+	}
+	if args.Count > 0 && int64(len(samples)) > args.Count {
+		samples = samples[:args.Count]
+	}
+
+	res := &pb.Samples{
+		Key: args.Key,
+	}
+	for _, s := range samples {
+		res.Samples = append(res.Samples, &pb.Sample{
+			Timestamp: s.Ts,
+			Value:     s.Val,
+		})
+	}
+	return res, nil
+}
+
+// isRuleDest reports whether key is the destination of a compaction rule.
+// Must be called with c.mu held.
+func (c *cache) isRuleDest(key string) bool {
+	found := false
+	c.store.Ascend("", func(k string, v interface{}) bool {
+		if ts, ok := v.(*dt.TimeSeriesT); ok {
+			for _, r := range ts.Rules {
+				if r.Dest == key {
+					found = true
+				}
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// TSCreateRule downsamples new samples of source into dest. Both series
+// must exist and a series can either be the source or the destination of
+// rules, not both.
+func (c *cache) TSCreateRule(ctx context.Context, args *pb.TSRuleRequest) (*pb.Response, error) {
+	bucket := durationMs(args.Bucket)
+	if args.Aggregation == pb.Aggregation_AGG_NONE || bucket <= 0 {
+		return nil, ErrInvalidRule
+	}
+	if args.Source == args.Dest {
+		return nil, ErrRuleChain
+	}
+
+	c.mu.Lock()
+	source, err := c.getTimeSeries(args.Source)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+	dest, err := c.getTimeSeries(args.Dest)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+	if len(dest.Rules) > 0 || c.isRuleDest(args.Source) {
+		c.mu.Unlock()
+		return nil, ErrRuleChain
+	}
+	for _, r := range source.Rules {
+		if r.Dest == args.Dest {
+			c.mu.Unlock()
+			return nil, ErrRuleExists
+		}
+	}
+
+	source.Rules = append(source.Rules, &dt.CompactionRule{
+		Dest:        args.Dest,
+		Aggregation: dt.Aggregation(args.Aggregation),
+		Bucket:      bucket,
+	})
+	c.notify(pb.EventType_UPDATE, args.Source)
+	c.mu.Unlock()
+
+	return &pb.Response{
+		Response: true,
+	}, nil
+}
+
+func (c *cache) TSDeleteRule(ctx context.Context, args *pb.TSRuleRequest) (*pb.Response, error) {
+	c.mu.Lock()
+	source, err := c.getTimeSeries(args.Source)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	if !source.DeleteRule(args.Dest) {
+		c.mu.Unlock()
+		return nil, ErrNoRule
+	}
+	c.notify(pb.EventType_UPDATE, args.Source)
+	c.mu.Unlock()
+
+	return &pb.Response{
+		Response: true,
+	}, nil
+}
+
+func (c *cache) TSInfo(ctx context.Context, args *pb.Key) (*pb.TSInfoResult, error) {
+	c.mu.RLock()
+	ts, err := c.getTimeSeries(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+
+	info := &pb.TSInfoResult{
+		Samples:         int64(len(ts.Samples)),
+		RetentionMs:     ts.Retention,
+		Labels:          make(map[string]string),
+		DuplicatePolicy: pb.DuplicatePolicy(ts.DuplicatePolicy),
+	}
+	if len(ts.Samples) > 0 {
+		info.FirstTimestamp = ts.Samples[0].Ts
+		info.LastTimestamp = ts.Samples[len(ts.Samples)-1].Ts
+	}
+	for k, v := range ts.Labels {
+		info.Labels[k] = v
+	}
+	for _, r := range ts.Rules {
+		info.Rules = append(info.Rules, &pb.TSRule{
+			Dest:        r.Dest,
+			Aggregation: pb.Aggregation(r.Aggregation),
+			BucketMs:    r.Bucket,
+		})
+	}
+	c.mu.RUnlock()
+
+	return info, nil
+}
+
+// TSQueryIndex returns the keys of all time series that have every one of
+// the given labels.
+func (c *cache) TSQueryIndex(ctx context.Context, args *pb.TSLabelFilter) (*pb.Keys, error) {
+	res := &pb.Keys{}
+	c.mu.RLock()
+	c.store.Ascend("", func(key string, v interface{}) bool {
+		ts, ok := v.(*dt.TimeSeriesT)
+		if !ok || isExpired(ts.Expiration) {
+			return true
+		}
+		for k, v := range args.Labels {
+			if ts.Labels[k] != v {
+				return true
+			}
+		}
+		res.Keys = append(res.Keys, key)
+		return true
+	})
+	c.mu.RUnlock()
+
+	return res, nil
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
+)
+
+func TestTimeSeriesRuleDestination(t *testing.T) {
+	ctx := context.Background()
+	c := NewCacheService(time.Minute, time.Minute)
+	calls := []call{
+		{"TSCreate", &pb.TSCreateRequest{Key: "ts"}},
+		{"TSCreate", &pb.TSCreateRequest{Key: "deleted"}},
+		{"TSCreate", &pb.TSCreateRequest{Key: "short", Retention: "1s"}},
+		{"TSCreateRule", &pb.TSRuleRequest{Source: "ts", Dest: "deleted", Aggregation: pb.Aggregation_AGG_SUM, Bucket: "1s"}},
+		{"TSCreateRule", &pb.TSRuleRequest{Source: "ts", Dest: "short", Aggregation: pb.Aggregation_AGG_SUM, Bucket: "1s"}},
+		{"TSAdd", &pb.TSAddRequest{Key: "short", Timestamp: 10000, Value: 1}},
+		{"DeleteKey", &pb.Key{Key: "deleted"}},
+		{"TSAdd", &pb.TSAddRequest{Key: "ts", Timestamp: 1000, Value: 1}},
+	}
+	for _, cl := range calls {
+		if err := c.invoke(ctx, cl); err != nil {
+			t.Fatalf("%s: %v", cl.method, err)
+		}
+	}
+
+	// Closing the bucket compacts a sample too old for short.
+	_, err := c.TSAdd(ctx, &pb.TSAddRequest{Key: "ts", Timestamp: 2000, Value: 1})
+	if err != dt.ErrSampleTooOld {
+		t.Errorf("compacting into short failed with %v, want ErrSampleTooOld", err)
+	}
+	info, err := c.TSInfo(ctx, &pb.Key{Key: "ts"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(info.Rules) != 1 || info.Rules[0].Dest != "short" {
+		t.Errorf("rules %v left, want only the rule into short", info.Rules)
+	}
+	if last, err := c.TSGet(ctx, &pb.Key{Key: "ts"}); err != nil || last.Timestamp != 2000 {
+		t.Errorf("last sample %v %v, want the sample at 2000", last, err)
+	}
+}
+
+func TestTimeSeriesCreateExpired(t *testing.T) {
+	ctx := context.Background()
+	c := NewCacheService(time.Minute, time.Minute)
+	if _, err := c.Set(ctx, &pb.String{Key: "ts", Value: "v", Expiration: "1ms"}); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	if _, err := c.TSCreate(ctx, &pb.TSCreateRequest{Key: "ts"}); err != nil {
+		t.Fatalf("creating a series over an expired key: %v", err)
+	}
+	if _, err := c.TSCreate(ctx, &pb.TSCreateRequest{Key: "ts"}); err != ErrKeyExists {
+		t.Errorf("creating an existing series failed with %v, want ErrKeyExists", err)
+	}
+	if _, err := c.TSAdd(ctx, &pb.TSAddRequest{Key: "ts", Timestamp: 1, Value: 1}); err != nil {
+		t.Errorf("adding to the created series: %v", err)
+	}
+}
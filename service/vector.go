@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/shanukun/cash/cash_proto"
+	dt "github.com/shanukun/cash/datatypes"
+)
+
+var (
+	ErrInvalidVectorIndex = errors.New("Invalid vector index parameters")
+	ErrVectorDim          = errors.New("Vector dimension does not match the index")
+)
+
+// getVector returns the vector index at key. Must be called with c.mu
+// held.
+func (c *cache) getVector(key string) (*dt.VectorT, error) {
+	kr := genKeyReport(c, key, vectorType)
+	if !kr.exists {
+		return nil, ErrNoKey
+	}
+	if !kr.typeMatch {
+		return nil, ErrWrongType
+	}
+
+	v := (kr.val).(*dt.VectorT)
+	if isExpired(v.Expiration) {
+		return nil, ErrKeyExpired
+	}
+	return v, nil
+}
+
+func (c *cache) VCreate(ctx context.Context, args *pb.VectorCreateRequest) (*pb.Response, error) {
+	if args.Dim <= 0 || args.M < 0 || args.EfConstruction < 0 || args.M == 1 {
+		return nil, ErrInvalidVectorIndex
+	}
+	m := int(args.M)
+	if m == 0 {
+		m = dt.DefaultHNSWM
+	}
+	efConstruction := int(args.EfConstruction)
+	if efConstruction == 0 {
+		efConstruction = dt.DefaultHNSWEfConstruction
+	}
+
+	c.mu.Lock()
+	kr := genKeyReport(c, args.Key, vectorType)
+	if kr.exists && !isExpired(dt.Expiration(kr.val)) {
+		c.mu.Unlock()
+		return nil, ErrKeyExists
+	}
+	c.store.Delete(args.Key)
+
+	expiration := getExpiration(args.Expiration)
+	v := dt.NewVector(int(args.Dim), dt.VectorMetric(args.Metric), dt.VectorAlgorithm(args.Algorithm), m, efConstruction, expiration)
+	c.store.Insert(args.Key, dt.AnyT(v))
+	c.expList[args.Key] = expiration
+	c.notify(pb.EventType_UPDATE, args.Key)
+	c.mu.Unlock()
+
+	return &pb.Response{
+		Response: true,
+	}, nil
+}
+
+// VAdd adds vectors to the index at key, replacing vectors with the same
+// id, and returns the number of vectors added.
+func (c *cache) VAdd(ctx context.Context, args *pb.VectorAddRequest) (*pb.Count, error) {
+	c.mu.Lock()
+	v, err := c.getVector(args.Key)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+	for _, vec := range args.Vectors {
+		if len(vec.Values) != v.Dim {
+			c.mu.Unlock()
+			return nil, ErrVectorDim
+		}
+	}
+
+	var added int64
+	for _, vec := range args.Vectors {
+		if v.Add(vec.Id, vec.Values) {
+			added++
+		}
+	}
+	c.notify(pb.EventType_UPDATE, args.Key)
+	c.mu.Unlock()
+
+	return &pb.Count{
+		Count: added,
+	}, nil
+}
+
+func (c *cache) VDel(ctx context.Context, args *pb.VectorIDs) (*pb.Count, error) {
+	c.mu.Lock()
+	v, err := c.getVector(args.Key)
+	if err != nil {
+		c.mu.Unlock()
+		return nil, err
+	}
+
+	var deleted int64
+	for _, id := range args.Ids {
+		if v.Delete(id) {
+			deleted++
+		}
+	}
+	if deleted > 0 {
+		c.notify(pb.EventType_UPDATE, args.Key)
+	}
+	c.mu.Unlock()
+
+	return &pb.Count{
+		Count: deleted,
+	}, nil
+}
+
+// VGet returns the vectors of the given ids that exist. Vectors of a
+// cosine index are returned normalized.
+func (c *cache) VGet(ctx context.Context, args *pb.VectorIDs) (*pb.Vectors, error) {
+	c.mu.RLock()
+	v, err := c.getVector(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+
+	res := &pb.Vectors{}
+	for _, id := range args.Ids {
+		if vec, exists := v.Get(id); exists {
+			res.Vectors = append(res.Vectors, &pb.Vector{
+				Id:     id,
+				Values: append([]float32(nil), vec...),
+			})
+		}
+	}
+	c.mu.RUnlock()
+
+	return res, nil
+}
+
+// VSearch returns the args.Count vectors closest to args.Vector, closest
+// first. HNSW indexes consider args.Ef candidates and search every vector
+// if args.Exact is set.
+func (c *cache) VSearch(ctx context.Context, args *pb.VectorSearchRequest) (*pb.VectorResults, error) {
+	count := int(args.Count)
+	if count <= 0 {
+		count = 10
+	}
+	ef := int(args.Ef)
+	if ef <= 0 {
+		ef = dt.DefaultHNSWEfSearch
+	}
+
+	c.mu.RLock()
+	v, err := c.getVector(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+	if len(args.Vector) != v.Dim {
+		c.mu.RUnlock()
+		return nil, ErrVectorDim
+	}
+	matches := v.Search(args.Vector, count, ef, args.Exact)
+	c.mu.RUnlock()
+
+	res := &pb.VectorResults{}
+	for _, m := range matches {
+		res.Results = append(res.Results, &pb.VectorResult{
+			Id:       m.ID,
+			Distance: m.Distance,
+		})
+	}
+	return res, nil
+}
+
+func (c *cache) VInfo(ctx context.Context, args *pb.Key) (*pb.VectorInfo, error) {
+	c.mu.RLock()
+	v, err := c.getVector(args.Key)
+	if err != nil {
+		c.mu.RUnlock()
+		return nil, err
+	}
+
+	info := &pb.VectorInfo{
+		Dim:            int32(v.Dim),
+		Metric:         pb.VectorMetric(v.Metric),
+		Algorithm:      pb.VectorAlgorithm(v.Algorithm),
+		Vectors:        int64(v.Len()),
+		M:              int32(v.M),
+		EfConstruction: int32(v.EfConstruction),
+	}
+	c.mu.RUnlock()
+
+	return info, nil
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/shanukun/cash/cash_proto"
+)
+
+func TestVectorCreateExpired(t *testing.T) {
+	ctx := context.Background()
+	c := NewCacheService(time.Minute, time.Minute)
+	if _, err := c.Set(ctx, &pb.String{Key: "v", Value: "v", Expiration: "1ms"}); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	if _, err := c.VCreate(ctx, &pb.VectorCreateRequest{Key: "v", Dim: 2}); err != nil {
+		t.Fatalf("creating an index over an expired key: %v", err)
+	}
+	if _, err := c.VCreate(ctx, &pb.VectorCreateRequest{Key: "v", Dim: 2}); err != ErrKeyExists {
+		t.Errorf("creating an existing index failed with %v, want ErrKeyExists", err)
+	}
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	pb "github.com/shanukun/cash/cash_proto"
+)
+
+var (
+	ErrRevisionCompacted = errors.New("Revision compacted, resync required")
+	ErrSlowWatcher       = errors.New("Watcher disconnected, buffer full")
+)
+
+const (
+	defaultWatchBuffer = 256
+	watchHistory       = 1024
+)
+
+// watcher is a single Watch stream. Watchers must not silently miss
+// events, so a watcher that can not keep up is disconnected and is
+// expected to reconnect from the last revision it has seen.
+type watcher struct {
+	match    func(key string) bool
+	events   chan *pb.WatchEvent
+	kicked   chan struct{}
+	kickOnce sync.Once
+}
+
+func (w *watcher) kick() {
+	w.kickOnce.Do(func() {
+		close(w.kicked)
+	})
+}
+
+// watchHub numbers every change to the keyspace with a revision and keeps
+// the most recent events so that reconnecting watchers can catch up.
+type watchHub struct {
+	mu       sync.Mutex
+	revision int64
+	history  []*pb.WatchEvent
+	watchers map[*watcher]struct{}
+}
+
+func newWatchHub() *watchHub {
+	return &watchHub{
+		watchers: make(map[*watcher]struct{}),
+	}
+}
+
+func watchMatcher(args *pb.WatchRequest) func(key string) bool {
+	switch t := args.Target.(type) {
+	case *pb.WatchRequest_Key:
+		return func(key string) bool { return key == t.Key }
+	case *pb.WatchRequest_Prefix:
+		return func(key string) bool { return strings.HasPrefix(key, t.Prefix) }
+	case *pb.WatchRequest_Pattern:
+		return func(key string) bool { return matchGlob(t.Pattern, key) }
+	}
+	return func(key string) bool { return true }
+}
+
+func (h *watchHub) emit(typ pb.EventType, key string) {
+	h.mu.Lock()
+	h.revision++
+	ev := &pb.WatchEvent{
+		Type:     typ,
+		Key:      key,
+		Revision: h.revision,
+	}
+
+	h.history = append(h.history, ev)
+	if len(h.history) > watchHistory {
+		h.history = h.history[len(h.history)-watchHistory:]
+	}
+
+	for w := range h.watchers {
+		if typ != pb.EventType_FLUSH && !w.match(key) {
+			continue
+		}
+		select {
+		case w.events <- ev:
+		default:
+			w.kick()
+		}
+	}
+	h.mu.Unlock()
+}
+
+// watch registers a watcher, replaying retained events after
+// args.StartRevision when it is set.
+func (h *watchHub) watch(args *pb.WatchRequest) (*watcher, error) {
+	buffer := int(args.Buffer)
+	if buffer <= 0 {
+		buffer = defaultWatchBuffer
+	}
+	match := watchMatcher(args)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var replay []*pb.WatchEvent
+	if args.StartRevision > 0 && args.StartRevision < h.revision {
+		oldest := h.revision - int64(len(h.history)) + 1
+		if args.StartRevision+1 < oldest {
+			return nil, ErrRevisionCompacted
+		}
+		for _, ev := range h.history[args.StartRevision+1-oldest:] {
+			if ev.Type == pb.EventType_FLUSH || match(ev.Key) {
+				replay = append(replay, ev)
+			}
+		}
+	}
+
+	w := &watcher{
+		match:  match,
+		events: make(chan *pb.WatchEvent, buffer+len(replay)),
+		kicked: make(chan struct{}),
+	}
+	for _, ev := range replay {
+		w.events <- ev
+	}
+	h.watchers[w] = struct{}{}
+	return w, nil
+}
+
+func (h *watchHub) unwatch(w *watcher) {
+	h.mu.Lock()
+	delete(h.watchers, w)
+	h.mu.Unlock()
+}
+
+// notify records a change to key for watchers, and for replicas when
+// changes are tracked. It is called from the write paths with c.mu held,
+// so revisions follow the order in which changes are applied. A key being
+// sent by MigrateKeys is then kept here.
+func (c *cache) notify(typ pb.EventType, key string) {
+	c.watch.emit(typ, key)
+	if c.changes != nil {
+		c.changes.add(typ, key)
+	}
+	if typ == pb.EventType_FLUSH {
+		c.sending = make(map[string]bool)
+	} else {
+		delete(c.sending, key)
+	}
+}
+
+func (c *cache) Watch(args *pb.WatchRequest, stream pb.CacheService_WatchServer) error {
+	w, err := c.watch.watch(args)
+	if err != nil {
+		return err
+	}
+	defer c.watch.unwatch(w)
+
+	ctx := stream.Context()
+	for {
+		select {
+		case ev := <-w.events:
+			if err := stream.Send(ev); err != nil {
+				return err
+			}
+		case <-w.kicked:
+			return ErrSlowWatcher
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}